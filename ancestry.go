@@ -0,0 +1,28 @@
+package git
+
+import (
+	"fmt"
+	"strings"
+)
+
+// IsAncestor identifies if the ancestor reference is an ancestor of the
+// descendant reference. Typically a reference can be either a commit hash,
+// branch name or tag. A reference is always considered to be an ancestor
+// of itself. This can be used to identify if a release has been merged. The
+// result is determined from the exit code of the following command:
+//
+//	git merge-base --is-ancestor '<ancestor>' '<descendant>'
+func (c *Client) IsAncestor(ancestor, descendant string) (bool, error) {
+	_, err := c.Exec(fmt.Sprintf("git merge-base --is-ancestor '%s' '%s'",
+		escapeQuotes(strings.TrimSpace(ancestor)), escapeQuotes(strings.TrimSpace(descendant))))
+	if err != nil {
+		// Git exits with an exit code of 1 if the reference is not an
+		// ancestor. Any other exit code signals a failure
+		if isExitCode(err, 1) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return true, nil
+}
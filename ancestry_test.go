@@ -0,0 +1,54 @@
+package git_test
+
+import (
+	"testing"
+
+	git "github.com/purpleclay/gitz"
+	"github.com/purpleclay/gitz/gittest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsAncestor(t *testing.T) {
+	log := `(main, origin/main) feat: add batarang to utility belt
+(tag: 0.1.0) feat: add utility belt`
+	gittest.InitRepository(t, gittest.WithLog(log))
+
+	client, _ := git.NewClient()
+	ancestor, err := client.IsAncestor("0.1.0", gittest.DefaultBranch)
+
+	require.NoError(t, err)
+	assert.True(t, ancestor)
+}
+
+func TestIsAncestorUnrelatedCommit(t *testing.T) {
+	gittest.InitRepository(t)
+	gittest.MustExec(t, "git checkout --orphan unrelated")
+	gittest.CommitEmpty(t, "feat: an unrelated history")
+
+	client, _ := git.NewClient()
+	ancestor, err := client.IsAncestor("unrelated", gittest.DefaultBranch)
+
+	require.NoError(t, err)
+	assert.False(t, ancestor)
+}
+
+func TestIsAncestorUnknownRefError(t *testing.T) {
+	gittest.InitRepository(t)
+
+	client, _ := git.NewClient()
+	_, err := client.IsAncestor("unknown", gittest.DefaultBranch)
+
+	require.ErrorAs(t, err, &git.ErrGitExecCommand{})
+}
+
+func TestIsAncestorQuotedRef(t *testing.T) {
+	gittest.InitRepository(t, gittest.WithLocalCommits("feat: a new feature"))
+	gittest.Exec(t, `git branch "it's"`)
+
+	client, _ := git.NewClient()
+	ancestor, err := client.IsAncestor("it's", gittest.DefaultBranch)
+
+	require.NoError(t, err)
+	assert.True(t, ancestor)
+}
@@ -0,0 +1,71 @@
+package git
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// ArchiveOption provides a way for setting specific options during an
+// archive operation. Each supported option can customize the way the
+// archive is generated
+type ArchiveOption func(*archiveOptions)
+
+type archiveOptions struct {
+	Format string
+	Prefix string
+}
+
+// WithArchiveFormat sets the format of the generated archive, such as tar,
+// tar.gz or zip. By default, a tar archive is generated. An empty string
+// will be ignored
+func WithArchiveFormat(format string) ArchiveOption {
+	return func(opts *archiveOptions) {
+		opts.Format = strings.TrimSpace(format)
+	}
+}
+
+// WithArchivePrefix prepends a prefix to the path of each file within the
+// generated archive. A trailing slash is needed if the prefix is to be
+// treated as a directory, for example, project/. An empty string will
+// be ignored
+func WithArchivePrefix(prefix string) ArchiveOption {
+	return func(opts *archiveOptions) {
+		opts.Prefix = strings.TrimSpace(prefix)
+	}
+}
+
+// Archive generates an archive containing the tree of files associated
+// with a reference, such as a commit, branch or tag. The archive is
+// returned as raw bytes, allowing it to be written to a file or uploaded
+// directly
+func (c *Client) Archive(ref string, opts ...ArchiveOption) ([]byte, error) {
+	options := &archiveOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	var buf strings.Builder
+	buf.WriteString("git archive")
+
+	if options.Format != "" {
+		buf.WriteString(" --format=")
+		buf.WriteString(options.Format)
+	}
+
+	if options.Prefix != "" {
+		buf.WriteString(fmt.Sprintf(" --prefix='%s'", options.Prefix))
+	}
+
+	buf.WriteString(" ")
+	buf.WriteString(strings.TrimSpace(ref))
+
+	// Archives are binary and must be captured separately from any
+	// output written to stderr
+	var stdout, stderr bytes.Buffer
+	if err := c.run(buf.String(), &stdout, &stderr, &stderr); err != nil {
+		return nil, err
+	}
+
+	return stdout.Bytes(), nil
+}
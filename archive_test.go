@@ -0,0 +1,83 @@
+package git_test
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+
+	git "github.com/purpleclay/gitz"
+	"github.com/purpleclay/gitz/gittest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestArchive(t *testing.T) {
+	gittest.InitRepository(t, gittest.WithCommittedFiles("main.go", "internal/parser.go"))
+
+	client, _ := git.NewClient()
+	archive, err := client.Archive("HEAD")
+	require.NoError(t, err)
+
+	assert.ElementsMatch(t, []string{"README.md", "main.go", "internal/", "internal/parser.go"}, tarEntries(t, archive))
+}
+
+func TestArchiveWithArchivePrefix(t *testing.T) {
+	gittest.InitRepository(t, gittest.WithCommittedFiles("main.go"))
+
+	client, _ := git.NewClient()
+	archive, err := client.Archive("HEAD", git.WithArchivePrefix("project/"))
+	require.NoError(t, err)
+
+	assert.ElementsMatch(t, []string{"project/", "project/README.md", "project/main.go"}, tarEntries(t, archive))
+}
+
+func TestArchiveWithArchiveFormat(t *testing.T) {
+	gittest.InitRepository(t, gittest.WithCommittedFiles("main.go"))
+
+	client, _ := git.NewClient()
+	archive, err := client.Archive("HEAD", git.WithArchiveFormat("zip"))
+	require.NoError(t, err)
+
+	r, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+	require.NoError(t, err)
+
+	var entries []string
+	for _, f := range r.File {
+		entries = append(entries, f.Name)
+	}
+	assert.ElementsMatch(t, []string{"README.md", "main.go"}, entries)
+}
+
+func TestArchiveUnknownRefError(t *testing.T) {
+	gittest.InitRepository(t)
+
+	client, _ := git.NewClient()
+	_, err := client.Archive("does-not-exist")
+
+	require.ErrorAs(t, err, &git.ErrGitExecCommand{})
+}
+
+func tarEntries(t *testing.T, archive []byte) []string {
+	t.Helper()
+
+	var entries []string
+	r := tar.NewReader(bytes.NewReader(archive))
+	for {
+		hdr, err := r.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		require.NoError(t, err)
+
+		// Skip the global header that contains the commit hash
+		if hdr.Typeflag == tar.TypeXGlobalHeader {
+			continue
+		}
+		entries = append(entries, hdr.Name)
+	}
+
+	return entries
+}
@@ -0,0 +1,166 @@
+package git
+
+import (
+	"bufio"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// BlameOption provides a way for setting specific options during a blame
+// operation. Each supported option can customize the lines of a file that
+// are attributed to commits
+type BlameOption func(*blameOptions)
+
+type blameOptions struct {
+	End   int
+	Start int
+}
+
+// WithBlameRange limits the blame to a range of lines within the file,
+// from start to end inclusive. Line numbers start at one. Any range that
+// starts at a line number less than one is ignored
+func WithBlameRange(start, end int) BlameOption {
+	return func(opts *blameOptions) {
+		opts.Start = start
+		opts.End = end
+	}
+}
+
+// BlameLine represents a single line within a file that has been
+// attributed to the commit that last modified it
+type BlameLine struct {
+	// Hash contains the unique identifier of the commit that last
+	// modified the line
+	Hash string
+
+	// Author contains details of the person who last modified the line
+	Author Person
+
+	// AuthorDate contains the date and time the line was last modified
+	AuthorDate time.Time
+
+	// LineNo contains the line number within the file, starting at one
+	LineNo int
+
+	// Content contains the content of the line
+	Content string
+}
+
+// Blame attributes each line within a file to the commit that last modified
+// it. The path to the file is relative to the root of the current repository
+// (working directory). Raw output is parsed from the following command:
+//
+//	git blame --porcelain -- '<path>'
+func (c *Client) Blame(path string, opts ...BlameOption) ([]BlameLine, error) {
+	options := &blameOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	var buf strings.Builder
+	buf.WriteString("git blame --porcelain")
+
+	if options.Start > 0 {
+		buf.WriteString(fmt.Sprintf(" -L %d,", options.Start))
+		if options.End > 0 {
+			buf.WriteString(strconv.Itoa(options.End))
+		}
+	}
+
+	buf.WriteString(fmt.Sprintf(" -- '%s'", strings.TrimSpace(path)))
+
+	out, err := c.Exec(buf.String())
+	if err != nil {
+		return nil, err
+	}
+
+	return parseBlame(out), nil
+}
+
+type blameCommit struct {
+	Author     Person
+	AuthorTime int64
+	AuthorTZ   string
+}
+
+func parseBlame(out string) []BlameLine {
+	var lines []BlameLine
+
+	// Details of each commit are only written once, the first time it is
+	// referenced within the porcelain output
+	commits := map[string]*blameCommit{}
+
+	var line BlameLine
+	var commit *blameCommit
+
+	scanner := bufio.NewScanner(strings.NewReader(out))
+	for scanner.Scan() {
+		text := scanner.Text()
+
+		// Each line of content is prefixed with a tab and always terminates an entry
+		if content, found := strings.CutPrefix(text, "\t"); found {
+			line.Content = content
+			if commit != nil {
+				line.Author = commit.Author
+				line.AuthorDate = blameDate(commit.AuthorTime, commit.AuthorTZ)
+			}
+			lines = append(lines, line)
+			continue
+		}
+
+		key, value, _ := strings.Cut(text, " ")
+		switch key {
+		case "author":
+			commit.Author.Name = value
+		case "author-mail":
+			commit.Author.Email = strings.Trim(value, "<>")
+		case "author-time":
+			commit.AuthorTime, _ = strconv.ParseInt(value, 10, 64)
+		case "author-tz":
+			commit.AuthorTZ = value
+		default:
+			// <hash> <original line> <final line> [<number of lines>]
+			fields := strings.Fields(text)
+			if len(fields) < 3 || !isHash(fields[0]) {
+				continue
+			}
+
+			lineNo, _ := strconv.Atoi(fields[2])
+			line = BlameLine{Hash: fields[0], LineNo: lineNo}
+
+			if _, found := commits[line.Hash]; !found {
+				commits[line.Hash] = &blameCommit{}
+			}
+			commit = commits[line.Hash]
+		}
+	}
+
+	return lines
+}
+
+func isHash(str string) bool {
+	if len(str) != 40 && len(str) != 64 {
+		return false
+	}
+
+	for _, r := range str {
+		if !strings.ContainsRune("0123456789abcdef", r) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func blameDate(unix int64, tz string) time.Time {
+	date := time.Unix(unix, 0)
+
+	zone, err := time.Parse("-0700", tz)
+	if err != nil {
+		return date
+	}
+
+	return date.In(zone.Location())
+}
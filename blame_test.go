@@ -0,0 +1,84 @@
+package git_test
+
+import (
+	"testing"
+	"time"
+
+	git "github.com/purpleclay/gitz"
+	"github.com/purpleclay/gitz/gittest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlame(t *testing.T) {
+	gittest.InitRepository(t)
+	gittest.StagedFile(t, "blame.txt", "a line that will be blamed")
+	date := time.Date(2023, time.April, 1, 12, 0, 0, 0, time.FixedZone("", -5*3600))
+
+	client, _ := git.NewClient()
+	_, err := client.Commit("feat: add a file to blame",
+		git.WithAuthor("joker", "joker@dc.com"),
+		git.WithCommitDate(date))
+	require.NoError(t, err)
+
+	lines, err := client.Blame("blame.txt")
+	require.NoError(t, err)
+
+	require.Len(t, lines, 1)
+	assert.Equal(t, gittest.LastCommit(t).Hash, lines[0].Hash)
+	assert.Equal(t, git.Person{Name: "joker", Email: "joker@dc.com"}, lines[0].Author)
+	assert.True(t, date.Equal(lines[0].AuthorDate))
+	assert.Equal(t, 1, lines[0].LineNo)
+	assert.Equal(t, "a line that will be blamed", lines[0].Content)
+}
+
+func TestBlameMultipleCommits(t *testing.T) {
+	gittest.InitRepository(t)
+	gittest.StagedFile(t, "blame.txt", "line 1\nline 2\nline 3")
+	gittest.CommitWithAuthor(t, "joker", "joker@dc.com", "feat: add a file to blame")
+	firstHash := gittest.LastCommit(t).Hash
+
+	overwriteFile(t, "blame.txt", "line 1\nline 2 changed\nline 3")
+	gittest.StageFile(t, "blame.txt")
+	gittest.Commit(t, "fix: change the second line")
+	secondHash := gittest.LastCommit(t).Hash
+
+	client, _ := git.NewClient()
+	lines, err := client.Blame("blame.txt")
+	require.NoError(t, err)
+
+	require.Len(t, lines, 3)
+	assert.Equal(t, firstHash, lines[0].Hash)
+	assert.Equal(t, "joker", lines[0].Author.Name)
+	assert.Equal(t, secondHash, lines[1].Hash)
+	assert.Equal(t, gittest.DefaultAuthorName, lines[1].Author.Name)
+	assert.Equal(t, "line 2 changed", lines[1].Content)
+	assert.Equal(t, firstHash, lines[2].Hash)
+	assert.Equal(t, "joker", lines[2].Author.Name)
+	assert.Equal(t, 3, lines[2].LineNo)
+}
+
+func TestBlameWithBlameRange(t *testing.T) {
+	gittest.InitRepository(t)
+	gittest.StagedFile(t, "blame.txt", "line 1\nline 2\nline 3\nline 4")
+	gittest.Commit(t, "feat: add a file to blame")
+
+	client, _ := git.NewClient()
+	lines, err := client.Blame("blame.txt", git.WithBlameRange(2, 3))
+	require.NoError(t, err)
+
+	require.Len(t, lines, 2)
+	assert.Equal(t, 2, lines[0].LineNo)
+	assert.Equal(t, "line 2", lines[0].Content)
+	assert.Equal(t, 3, lines[1].LineNo)
+	assert.Equal(t, "line 3", lines[1].Content)
+}
+
+func TestBlameMissingFileError(t *testing.T) {
+	gittest.InitRepository(t)
+
+	client, _ := git.NewClient()
+	_, err := client.Blame("missing.txt")
+
+	require.ErrorAs(t, err, &git.ErrGitExecCommand{})
+}
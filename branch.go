@@ -0,0 +1,282 @@
+package git
+
+import (
+	"strings"
+)
+
+// CreateBranchOption provides a way for setting specific options during a
+// branch creation operation. Each supported option can customize the way
+// the branch is created within the current repository (working directory)
+type CreateBranchOption func(*createBranchOptions)
+
+type createBranchOptions struct {
+	Force      bool
+	StartPoint string
+}
+
+// WithBranchStartPoint ensures the created branch points to a specific
+// reference within the history of the repository, such as a commit, tag
+// or another branch. This changes the default behavior of creating a branch
+// against the HEAD (or latest commit) of the repository. An empty string
+// will be ignored
+func WithBranchStartPoint(ref string) CreateBranchOption {
+	return func(opts *createBranchOptions) {
+		opts.StartPoint = strings.TrimSpace(ref)
+	}
+}
+
+// WithForceBranch will reset an existing branch to the start point, rather
+// than refusing to create it. The current branch cannot be reset
+func WithForceBranch() CreateBranchOption {
+	return func(opts *createBranchOptions) {
+		opts.Force = true
+	}
+}
+
+// CreateBranch creates a new branch within the current repository (working
+// directory) without checking it out. By default, the branch will point to the
+// HEAD (or latest commit) of the repository
+func (c *Client) CreateBranch(name string, opts ...CreateBranchOption) (string, error) {
+	options := &createBranchOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	var buf strings.Builder
+	buf.WriteString("git branch")
+
+	if options.Force {
+		buf.WriteString(" --force")
+	}
+
+	buf.WriteString(" ")
+	buf.WriteString(strings.TrimSpace(name))
+
+	if options.StartPoint != "" {
+		buf.WriteString(" ")
+		buf.WriteString(options.StartPoint)
+	}
+
+	return c.Exec(buf.String())
+}
+
+// DeleteBranchOption provides a way for setting specific options during
+// a branch deletion operation
+type DeleteBranchOption func(*deleteBranchOptions)
+
+type deleteBranchOptions struct {
+	Force  bool
+	Remote bool
+}
+
+// WithForceDelete will delete a branch, irrespective of whether it has
+// been fully merged into its upstream branch
+func WithForceDelete() DeleteBranchOption {
+	return func(opts *deleteBranchOptions) {
+		opts.Force = true
+	}
+}
+
+// WithRemoteDelete ensures the deletion of each branch is also pushed
+// back to the remote
+func WithRemoteDelete() DeleteBranchOption {
+	return func(opts *deleteBranchOptions) {
+		opts.Remote = true
+	}
+}
+
+// DeleteBranch will attempt to delete a series of local branches from the
+// current repository (working directory). By default, a branch must be fully
+// merged before it can be deleted
+func (c *Client) DeleteBranch(names []string, opts ...DeleteBranchOption) (string, error) {
+	names = trim(names...)
+	if len(names) == 0 {
+		return "", nil
+	}
+
+	options := &deleteBranchOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	var buf strings.Builder
+	buf.WriteString("git branch")
+
+	if options.Force {
+		buf.WriteString(" -D ")
+	} else {
+		buf.WriteString(" -d ")
+	}
+	buf.WriteString(strings.Join(names, " "))
+
+	out, err := c.Exec(buf.String())
+	if err != nil {
+		return out, err
+	}
+
+	if !options.Remote {
+		return out, nil
+	}
+
+	return c.Push(WithDeleteRefSpecs(names...))
+}
+
+// RenameBranchOption provides a way for setting specific options during
+// a branch rename operation
+type RenameBranchOption func(*renameBranchOptions)
+
+type renameBranchOptions struct {
+	Force bool
+}
+
+// WithForceRename will rename a branch, even if a branch with the new
+// name already exists, overwriting it
+func WithForceRename() RenameBranchOption {
+	return func(opts *renameBranchOptions) {
+		opts.Force = true
+	}
+}
+
+// RenameBranch renames an existing branch within the current repository
+// (working directory), including the current branch if checked out. Any
+// config and reflog associated with the branch are renamed with it
+func (c *Client) RenameBranch(oldName, newName string, opts ...RenameBranchOption) (string, error) {
+	options := &renameBranchOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	var buf strings.Builder
+	buf.WriteString("git branch")
+
+	if options.Force {
+		buf.WriteString(" -M ")
+	} else {
+		buf.WriteString(" -m ")
+	}
+	buf.WriteString(strings.TrimSpace(oldName))
+	buf.WriteString(" ")
+	buf.WriteString(strings.TrimSpace(newName))
+
+	return c.Exec(buf.String())
+}
+
+// ListBranchesOption provides a way for setting specific options during a
+// list branches operation. Each supported option can customize the way in
+// which branches are queried and returned from the current repository
+// (working directory)
+type ListBranchesOption func(*listBranchesOptions)
+
+type listBranchesOptions struct {
+	Contains string
+	Merged   string
+	NoMerged string
+	Remotes  bool
+	SortBy   []string
+}
+
+// WithBranchContains limits the retrieved branches to those that contain
+// the provided reference, such as a commit, within their history. An empty
+// string will be ignored
+func WithBranchContains(ref string) ListBranchesOption {
+	return func(opts *listBranchesOptions) {
+		opts.Contains = strings.TrimSpace(ref)
+	}
+}
+
+// WithBranchMerged limits the retrieved branches to those whose tips are
+// reachable from the provided reference, meaning they have been merged.
+// An empty string will be ignored
+func WithBranchMerged(ref string) ListBranchesOption {
+	return func(opts *listBranchesOptions) {
+		opts.Merged = strings.TrimSpace(ref)
+	}
+}
+
+// WithBranchNoMerged limits the retrieved branches to those whose tips
+// are not reachable from the provided reference, meaning they have not
+// been merged. An empty string will be ignored
+func WithBranchNoMerged(ref string) ListBranchesOption {
+	return func(opts *listBranchesOptions) {
+		opts.NoMerged = strings.TrimSpace(ref)
+	}
+}
+
+// WithBranchRemotes retrieves remote-tracking branches rather than local
+// branches. Each remote-tracking branch is prefixed with the name of its
+// remote:
+//
+//	origin/main
+func WithBranchRemotes() ListBranchesOption {
+	return func(opts *listBranchesOptions) {
+		opts.Remotes = true
+	}
+}
+
+// WithBranchSortBy allows the retrieved order of branches to be changed by
+// sorting against a reserved [field name]. By default, sorting will always be
+// in ascending order. To change this behaviour, prefix a field name with a
+// hyphen (-<fieldname>). The last field name is treated as the primary key
+// for the entire sort. All leading and trailing whitespace will be trimmed,
+// allowing empty field names to be ignored
+//
+// [field name]: https://git-scm.com/docs/git-for-each-ref#_field_names
+func WithBranchSortBy(keys ...SortKey) ListBranchesOption {
+	return func(opts *listBranchesOptions) {
+		converted := make([]string, 0, len(keys))
+		for _, key := range keys {
+			converted = append(converted, key.String())
+		}
+
+		opts.SortBy = trimAndPrefix("--sort=", converted...)
+	}
+}
+
+// Branches retrieves all local branches from the current repository (working
+// directory). By default, all branches are retrieved in ascending lexicographic
+// order as implied through the [RefName] sort key. Options can be provided to
+// customize retrieval
+func (c *Client) Branches(opts ...ListBranchesOption) ([]string, error) {
+	options := &listBranchesOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	var buf strings.Builder
+	buf.WriteString("git branch --list --format='%(refname:short)'")
+
+	if options.Remotes {
+		buf.WriteString(" --remotes")
+	}
+
+	if options.Merged != "" {
+		buf.WriteString(" --merged ")
+		buf.WriteString(options.Merged)
+	}
+
+	if options.NoMerged != "" {
+		buf.WriteString(" --no-merged ")
+		buf.WriteString(options.NoMerged)
+	}
+
+	if options.Contains != "" {
+		buf.WriteString(" --contains ")
+		buf.WriteString(options.Contains)
+	}
+
+	for _, sort := range options.SortBy {
+		buf.WriteString(" ")
+		buf.WriteString(sort)
+	}
+
+	out, err := c.Exec(buf.String())
+	if err != nil {
+		return nil, err
+	}
+
+	if out == "" {
+		return nil, nil
+	}
+
+	return strings.Split(out, "\n"), nil
+}
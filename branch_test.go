@@ -0,0 +1,187 @@
+package git_test
+
+import (
+	"testing"
+
+	git "github.com/purpleclay/gitz"
+	"github.com/purpleclay/gitz/gittest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateBranch(t *testing.T) {
+	gittest.InitRepository(t)
+
+	client, _ := git.NewClient()
+	_, err := client.CreateBranch("feature")
+	require.NoError(t, err)
+
+	assert.ElementsMatch(t, []string{gittest.DefaultBranch, "feature"}, gittest.Branches(t))
+	assert.Equal(t, gittest.DefaultBranch, gittest.ShowBranch(t))
+}
+
+func TestCreateBranchWithBranchStartPoint(t *testing.T) {
+	log := `feat: a brand new feature
+chore: prepare for the next feature`
+	gittest.InitRepository(t, gittest.WithLog(log))
+	entries := gittest.Log(t)
+
+	client, _ := git.NewClient()
+	_, err := client.CreateBranch("feature", git.WithBranchStartPoint(entries[1].Hash))
+	require.NoError(t, err)
+
+	assert.Contains(t, gittest.Branches(t), "feature")
+	assert.Equal(t, entries[1].Hash, gittest.MustExec(t, "git rev-parse feature"))
+}
+
+func TestCreateBranchWithForceBranch(t *testing.T) {
+	log := `feat: a brand new feature
+(feature) chore: prepare for the next feature`
+	gittest.InitRepository(t, gittest.WithLog(log))
+
+	client, _ := git.NewClient()
+	_, err := client.CreateBranch("feature", git.WithForceBranch())
+	require.NoError(t, err)
+
+	assert.Equal(t, gittest.LastCommit(t).Hash, gittest.MustExec(t, "git rev-parse feature"))
+}
+
+func TestDeleteBranch(t *testing.T) {
+	log := "(main, feature1, feature2) feat: a brand new feature"
+	gittest.InitRepository(t, gittest.WithLog(log))
+
+	client, _ := git.NewClient()
+	_, err := client.DeleteBranch([]string{"feature1", "feature2"})
+	require.NoError(t, err)
+
+	assert.ElementsMatch(t, []string{gittest.DefaultBranch}, gittest.Branches(t))
+}
+
+func TestDeleteBranchNotMergedError(t *testing.T) {
+	gittest.InitRepository(t)
+	gittest.Exec(t, "git checkout -b feature")
+	gittest.CommitEmpty(t, "feat: a brand new feature")
+	gittest.Checkout(t, gittest.DefaultBranch)
+
+	client, _ := git.NewClient()
+	_, err := client.DeleteBranch([]string{"feature"})
+	require.Error(t, err)
+
+	_, err = client.DeleteBranch([]string{"feature"}, git.WithForceDelete())
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{gittest.DefaultBranch}, gittest.Branches(t))
+}
+
+func TestDeleteBranchWithRemoteDelete(t *testing.T) {
+	log := "(main, origin/main, feature, origin/feature) feat: a brand new feature"
+	gittest.InitRepository(t, gittest.WithLog(log))
+
+	client, _ := git.NewClient()
+	_, err := client.DeleteBranch([]string{"feature"}, git.WithRemoteDelete())
+	require.NoError(t, err)
+
+	assert.ElementsMatch(t, []string{gittest.DefaultBranch}, gittest.Branches(t))
+	assert.NotContains(t, gittest.RemoteBranches(t), "feature")
+}
+
+func TestRenameBranch(t *testing.T) {
+	log := "(main, feature) feat: a brand new feature"
+	gittest.InitRepository(t, gittest.WithLog(log))
+
+	client, _ := git.NewClient()
+	_, err := client.RenameBranch("feature", "renamed-feature")
+	require.NoError(t, err)
+
+	branches := gittest.Branches(t)
+	assert.Contains(t, branches, "renamed-feature")
+	assert.NotContains(t, branches, "feature")
+}
+
+func TestRenameBranchCurrentBranch(t *testing.T) {
+	gittest.InitRepository(t)
+	gittest.Exec(t, "git checkout -b feature")
+
+	client, _ := git.NewClient()
+	_, err := client.RenameBranch("feature", "renamed-feature")
+	require.NoError(t, err)
+
+	assert.Equal(t, "renamed-feature", gittest.ShowBranch(t))
+}
+
+func TestRenameBranchWithForceRename(t *testing.T) {
+	log := "(main, feature, existing) feat: a brand new feature"
+	gittest.InitRepository(t, gittest.WithLog(log))
+
+	client, _ := git.NewClient()
+	_, err := client.RenameBranch("feature", "existing")
+	require.Error(t, err)
+
+	_, err = client.RenameBranch("feature", "existing", git.WithForceRename())
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{gittest.DefaultBranch, "existing"}, gittest.Branches(t))
+}
+
+func TestBranches(t *testing.T) {
+	log := "(main, origin/main, feature2, feature1) feat: a brand new feature"
+	gittest.InitRepository(t, gittest.WithLog(log))
+
+	client, _ := git.NewClient()
+	branches, err := client.Branches()
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"feature1", "feature2", gittest.DefaultBranch}, branches)
+}
+
+func TestBranchesWithBranchRemotes(t *testing.T) {
+	log := "(main, origin/main, origin/feature) feat: a brand new feature"
+	gittest.InitRepository(t, gittest.WithLog(log))
+
+	client, _ := git.NewClient()
+	branches, err := client.Branches(git.WithBranchRemotes())
+	require.NoError(t, err)
+
+	assert.Contains(t, branches, "origin/feature")
+	assert.Contains(t, branches, gittest.DefaultRemoteBranch)
+}
+
+func TestBranchesWithBranchMerged(t *testing.T) {
+	gittest.InitRepository(t)
+	gittest.Exec(t, "git branch merged")
+	gittest.Exec(t, "git checkout -b unmerged")
+	gittest.CommitEmpty(t, "feat: a brand new feature")
+	gittest.Checkout(t, gittest.DefaultBranch)
+
+	client, _ := git.NewClient()
+	merged, err := client.Branches(git.WithBranchMerged(gittest.DefaultBranch))
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{gittest.DefaultBranch, "merged"}, merged)
+
+	unmerged, err := client.Branches(git.WithBranchNoMerged(gittest.DefaultBranch))
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"unmerged"}, unmerged)
+}
+
+func TestBranchesWithBranchContains(t *testing.T) {
+	gittest.InitRepository(t)
+	gittest.Exec(t, "git checkout -b feature")
+	gittest.CommitEmpty(t, "feat: a brand new feature")
+	hash := gittest.LastCommit(t).Hash
+	gittest.Checkout(t, gittest.DefaultBranch)
+
+	client, _ := git.NewClient()
+	branches, err := client.Branches(git.WithBranchContains(hash))
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"feature"}, branches)
+}
+
+func TestBranchesWithBranchSortBy(t *testing.T) {
+	log := "(main, origin/main, feature2, feature1) feat: a brand new feature"
+	gittest.InitRepository(t, gittest.WithLog(log))
+
+	client, _ := git.NewClient()
+	branches, err := client.Branches(git.WithBranchSortBy(git.RefNameDesc))
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{gittest.DefaultBranch, "feature2", "feature1"}, branches)
+}
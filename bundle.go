@@ -0,0 +1,40 @@
+package git
+
+import (
+	"fmt"
+	"strings"
+)
+
+// BundleCreate packages objects and references from the current repository
+// (working directory) into a single archive file at the given path. A bundle
+// can be transferred by any means and then cloned or fetched from, which is
+// ideal for air-gapped environments. Any number of references can be provided,
+// such as branches, tags, or ranges (e.g. main~2..main). If no references
+// are provided, all references will be bundled (--all). The following command
+// is executed:
+//
+//	git bundle create -q '<path>' <refs>
+func (c *Client) BundleCreate(path string, refs ...string) (string, error) {
+	var buf strings.Builder
+	buf.WriteString(fmt.Sprintf("git bundle create -q '%s'", escapeQuotes(path)))
+
+	refs = trim(refs...)
+	if len(refs) == 0 {
+		buf.WriteString(" --all")
+	}
+
+	for _, ref := range refs {
+		buf.WriteString(fmt.Sprintf(" '%s'", ref))
+	}
+
+	return c.Exec(buf.String())
+}
+
+// BundleVerify checks that the bundle at the given path is valid and can
+// be cleanly applied to the current repository (working directory). An
+// error is returned if the bundle is invalid or any prerequisite commits
+// are missing
+func (c *Client) BundleVerify(path string) error {
+	_, err := c.Exec(fmt.Sprintf("git bundle verify -q '%s'", escapeQuotes(path)))
+	return err
+}
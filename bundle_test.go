@@ -0,0 +1,43 @@
+package git_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	git "github.com/purpleclay/gitz"
+	"github.com/purpleclay/gitz/gittest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBundleCreate(t *testing.T) {
+	log := `(main, origin/main) feat: add batarang to utility belt
+feat: add utility belt`
+	gittest.InitRepository(t, gittest.WithLog(log))
+	bundle := filepath.Join(t.TempDir(), "batcave.bundle")
+
+	client, _ := git.NewClient()
+	_, err := client.BundleCreate(bundle, gittest.DefaultBranch)
+	require.NoError(t, err)
+	require.NoError(t, client.BundleVerify(bundle))
+
+	clone := filepath.Join(t.TempDir(), "clone")
+	gittest.MustExec(t, fmt.Sprintf("git clone '%s' '%s'", bundle, clone))
+
+	expected := gittest.MustExec(t, "git log --format=%H "+gittest.DefaultBranch)
+	assert.Equal(t, expected, gittest.MustExec(t, fmt.Sprintf("git -C '%s' log --format=%%H origin/%s",
+		clone, gittest.DefaultBranch)))
+}
+
+func TestBundleVerifyInvalidBundle(t *testing.T) {
+	gittest.InitRepository(t)
+	bundle := filepath.Join(t.TempDir(), "invalid.bundle")
+	require.NoError(t, os.WriteFile(bundle, []byte("not a bundle"), 0o600))
+
+	client, _ := git.NewClient()
+	err := client.BundleVerify(bundle)
+
+	require.ErrorAs(t, err, &git.ErrGitExecCommand{})
+}
@@ -0,0 +1,88 @@
+package git
+
+import (
+	"strconv"
+	"strings"
+)
+
+// CherryPickOption provides a way for setting specific options during a
+// cherry-pick operation. Each supported option can customize the way
+// commits are applied to the current repository (working directory)
+type CherryPickOption func(*cherryPickOptions)
+
+type cherryPickOptions struct {
+	Config   []string
+	Mainline int
+	NoCommit bool
+}
+
+// WithCherryPickConfig allows temporary git config to be set while
+// cherry-picking commits. Config set using this approach will override
+// any config defined within existing git config files. Config must be
+// provided as key value pairs, mismatched config will result in an
+// [ErrMissingConfigValue] error. Any invalid paths will result in an
+// [ErrInvalidConfigPath] error
+func WithCherryPickConfig(kv ...string) CherryPickOption {
+	return func(opts *cherryPickOptions) {
+		opts.Config = trim(kv...)
+	}
+}
+
+// WithMainline identifies the parent number (starting from one) of a
+// merge commit, allowing its changes to be replayed relative to that
+// parent. A merge commit cannot be cherry-picked without this option.
+// Any number less than one is ignored
+func WithMainline(n int) CherryPickOption {
+	return func(opts *cherryPickOptions) {
+		opts.Mainline = n
+	}
+}
+
+// WithNoCommit applies the changes from each commit to both the index
+// and working tree, without creating any commits
+func WithNoCommit() CherryPickOption {
+	return func(opts *cherryPickOptions) {
+		opts.NoCommit = true
+	}
+}
+
+// CherryPick applies the changes introduced by any number of existing commits
+// to the current repository (working directory), recording a new commit for
+// each. If a conflict occurs, an [ErrGitExecCommand] error is returned,
+// containing the raw git output, which will identify any conflicting files
+func (c *Client) CherryPick(refs []string, opts ...CherryPickOption) (string, error) {
+	options := &cherryPickOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	cfg, err := ToInlineConfig(options.Config...)
+	if err != nil {
+		return "", err
+	}
+
+	var buf strings.Builder
+	buf.WriteString("git")
+
+	if len(cfg) > 0 {
+		buf.WriteString(" ")
+		buf.WriteString(strings.Join(cfg, " "))
+	}
+	buf.WriteString(" cherry-pick")
+
+	if options.NoCommit {
+		buf.WriteString(" -n")
+	}
+
+	if options.Mainline > 0 {
+		buf.WriteString(" -m ")
+		buf.WriteString(strconv.Itoa(options.Mainline))
+	}
+
+	for _, ref := range trim(refs...) {
+		buf.WriteString(" ")
+		buf.WriteString(ref)
+	}
+
+	return c.Exec(buf.String())
+}
@@ -0,0 +1,52 @@
+package git_test
+
+import (
+	"testing"
+
+	git "github.com/purpleclay/gitz"
+	"github.com/purpleclay/gitz/gittest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCherryPick(t *testing.T) {
+	log := `(HEAD -> feature) fix: backport this critical fix
+(main, origin/main) chore: prepare for the next release`
+	gittest.InitRepository(t, gittest.WithLog(log))
+	hash := gittest.LastCommit(t).Hash
+	gittest.Checkout(t, gittest.DefaultBranch)
+
+	client, _ := git.NewClient()
+	_, err := client.CherryPick([]string{hash})
+	require.NoError(t, err)
+
+	localLog := gittest.Log(t)
+	assert.Equal(t, "fix: backport this critical fix", localLog[0].Message)
+	assert.Equal(t, gittest.DefaultBranch, gittest.ShowBranch(t))
+}
+
+func TestCherryPickWithNoCommit(t *testing.T) {
+	gittest.InitRepository(t)
+	gittest.Exec(t, "git checkout -b feature")
+	gittest.StagedFile(t, "fix.txt", "a critical fix")
+	gittest.Commit(t, "fix: backport this critical fix")
+	hash := gittest.LastCommit(t).Hash
+	gittest.Checkout(t, gittest.DefaultBranch)
+
+	client, _ := git.NewClient()
+	_, err := client.CherryPick([]string{hash}, git.WithNoCommit())
+	require.NoError(t, err)
+
+	assert.Equal(t, gittest.InitialCommit, gittest.LastCommit(t).Message)
+	assert.ElementsMatch(t, []string{"A  fix.txt"}, gittest.PorcelainStatus(t))
+}
+
+func TestCherryPickConflict(t *testing.T) {
+	gittest.InitRepository(t, gittest.WithCommittedFiles("conflict.txt"))
+	hash := conflictingBranches(t, "conflict.txt")
+
+	client, _ := git.NewClient()
+	_, err := client.CherryPick([]string{hash})
+	require.ErrorAs(t, err, &git.ErrGitExecCommand{})
+	assert.Contains(t, err.Error(), "CONFLICT")
+}
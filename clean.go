@@ -0,0 +1,92 @@
+package git
+
+import (
+	"strings"
+)
+
+const (
+	cleanRemovingPrefix    = "Removing "
+	cleanWouldRemovePrefix = "Would remove "
+)
+
+// CleanOption provides a way for setting specific options when removing
+// untracked files from the current repository (working directory). Each
+// supported option can customize what is removed
+type CleanOption func(*cleanOptions)
+
+type cleanOptions struct {
+	Directories bool
+	DryRun      bool
+	Force       bool
+}
+
+// WithCleanDirectories will also remove any untracked directories, in
+// addition to untracked files
+func WithCleanDirectories() CleanOption {
+	return func(opts *cleanOptions) {
+		opts.Directories = true
+	}
+}
+
+// WithCleanDryRun will report which untracked files would be removed,
+// without removing them
+func WithCleanDryRun() CleanOption {
+	return func(opts *cleanOptions) {
+		opts.DryRun = true
+	}
+}
+
+// WithCleanForce forces the removal of untracked files. By default, git
+// will refuse to remove any files, unless the clean.requireForce config
+// setting is set to false
+func WithCleanForce() CleanOption {
+	return func(opts *cleanOptions) {
+		opts.Force = true
+	}
+}
+
+// CleanUntracked removes all untracked files from the current repository
+// (working directory), returning the paths of the removed files. Files
+// excluded by .gitignore are not removed. Unless a dry run is requested
+// with [WithCleanDryRun], the [WithCleanForce] option must be provided
+func (c *Client) CleanUntracked(opts ...CleanOption) ([]string, error) {
+	options := &cleanOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	var buf strings.Builder
+	buf.WriteString("git clean")
+
+	if options.DryRun {
+		buf.WriteString(" --dry-run")
+	}
+
+	if options.Force {
+		buf.WriteString(" --force")
+	}
+
+	if options.Directories {
+		buf.WriteString(" -d")
+	}
+
+	out, err := c.Exec(buf.String())
+	if err != nil {
+		return nil, err
+	}
+
+	return parseCleanPaths(out), nil
+}
+
+func parseCleanPaths(out string) []string {
+	var paths []string
+	for _, line := range strings.Split(out, "\n") {
+		if path, found := strings.CutPrefix(line, cleanRemovingPrefix); found {
+			paths = append(paths, path)
+		} else if path, found := strings.CutPrefix(line, cleanWouldRemovePrefix); found {
+			paths = append(paths, path)
+		}
+	}
+
+	return paths
+}
@@ -0,0 +1,58 @@
+package git_test
+
+import (
+	"testing"
+
+	git "github.com/purpleclay/gitz"
+	"github.com/purpleclay/gitz/gittest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCleanUntracked(t *testing.T) {
+	gittest.InitRepository(t,
+		gittest.WithCommittedFiles("main.go"),
+		gittest.WithFiles("untracked.txt", "internal/untracked.go"))
+
+	client, _ := git.NewClient()
+	removed, err := client.CleanUntracked(git.WithCleanForce(), git.WithCleanDirectories())
+	require.NoError(t, err)
+
+	assert.ElementsMatch(t, []string{"untracked.txt", "internal/"}, removed)
+	assert.NoFileExists(t, "untracked.txt")
+	assert.NoDirExists(t, "internal")
+	assert.FileExists(t, "main.go")
+}
+
+func TestCleanUntrackedWithoutDirectories(t *testing.T) {
+	gittest.InitRepository(t, gittest.WithFiles("untracked.txt", "internal/untracked.go"))
+
+	client, _ := git.NewClient()
+	removed, err := client.CleanUntracked(git.WithCleanForce())
+	require.NoError(t, err)
+
+	assert.ElementsMatch(t, []string{"untracked.txt"}, removed)
+	assert.FileExists(t, "internal/untracked.go")
+}
+
+func TestCleanUntrackedWithCleanDryRun(t *testing.T) {
+	gittest.InitRepository(t, gittest.WithFiles("untracked.txt", "internal/untracked.go"))
+
+	client, _ := git.NewClient()
+	removed, err := client.CleanUntracked(git.WithCleanDryRun(), git.WithCleanDirectories())
+	require.NoError(t, err)
+
+	assert.ElementsMatch(t, []string{"untracked.txt", "internal/"}, removed)
+	assert.FileExists(t, "untracked.txt")
+	assert.FileExists(t, "internal/untracked.go")
+}
+
+func TestCleanUntrackedRequiresForceError(t *testing.T) {
+	gittest.InitRepository(t, gittest.WithFiles("untracked.txt"))
+
+	client, _ := git.NewClient()
+	_, err := client.CleanUntracked()
+
+	require.ErrorAs(t, err, &git.ErrGitExecCommand{})
+	assert.FileExists(t, "untracked.txt")
+}
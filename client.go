@@ -5,10 +5,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
+	"mvdan.cc/sh/v3/expand"
 	"mvdan.cc/sh/v3/interp"
 	"mvdan.cc/sh/v3/syntax"
 )
@@ -16,6 +19,8 @@ import (
 const (
 	disabledNumericOption = -1
 
+	defaultGitBinary = "git"
+
 	// RelativeAtRoot can be used to compare if a path is equivalent to the
 	// root of a current git repository working directory
 	RelativeAtRoot = "."
@@ -27,12 +32,18 @@ const (
 // ErrGitMissing is raised when no git client was identified
 // within the PATH environment variable on the current OS
 type ErrGitMissing struct {
+	// Binary contains the git binary that could not be found
+	Binary string
+
 	// PathEnv contains the value of the PATH environment variable
 	PathEnv string
 }
 
 // Error returns a friendly formatted message of the current error
 func (e ErrGitMissing) Error() string {
+	if e.Binary != "" && e.Binary != defaultGitBinary {
+		return fmt.Sprintf("git binary %s could not be found. PATH resolves to %s", e.Binary, e.PathEnv)
+	}
 	return fmt.Sprintf("git is not installed under the PATH environment variable. PATH resolves to %s", e.PathEnv)
 }
 
@@ -44,6 +55,10 @@ type ErrGitExecCommand struct {
 	// Out contains any raw output from the git client as a result
 	// of the error
 	Out string
+
+	// ExitCode contains the exit code returned by the git client. Will
+	// be zero if the command failed without exiting
+	ExitCode int
 }
 
 // Error returns a friendly formatted message of the current error
@@ -53,6 +68,21 @@ func (e ErrGitExecCommand) Error() string {
 %s`, e.Cmd, e.Out)
 }
 
+// ErrGitTimeout is raised when a git command fails to complete
+// within the timeout configured on the client
+type ErrGitTimeout struct {
+	// Cmd contains the command that exceeded the timeout
+	Cmd string
+
+	// Timeout contains the configured timeout that was exceeded
+	Timeout time.Duration
+}
+
+// Error returns a friendly formatted message of the current error
+func (e ErrGitTimeout) Error() string {
+	return fmt.Sprintf("git command timed out after %s: %s", e.Timeout, e.Cmd)
+}
+
 // ErrGitNonRelativePath is raised when attempting to resolve a path
 // within a git repository that isn't relative to the root of the
 // working directory
@@ -116,15 +146,72 @@ type Repository struct {
 // to an installed git client on the current OS. Git operations will be
 // mapped as closely as possible to the official Git specification
 type Client struct {
+	env        []string
+	gitBinary  string
 	gitVersion string
+	timeout    time.Duration
+	workingDir string
+}
+
+// ClientOption provides a way for setting specific options when creating
+// a new instance of the git client. Each supported option can customize
+// how the client executes git commands
+type ClientOption func(*Client)
+
+// WithGitBinary provides a path to a git binary that will be used in
+// place of any git client identified within the PATH environment variable.
+// All executed git commands will be handed-off to this binary. An empty
+// path will be ignored
+func WithGitBinary(path string) ClientOption {
+	return func(c *Client) {
+		if path = strings.TrimSpace(path); path != "" {
+			c.gitBinary = path
+		}
+	}
+}
+
+// WithWorkingDirectory ensures all git commands are executed against the
+// repository (working directory) at the provided path, rather than the
+// current working directory of the process. This allows multiple clients
+// to operate on different repositories within the same process. An empty
+// path will be ignored
+func WithWorkingDirectory(dir string) ClientOption {
+	return func(c *Client) {
+		c.workingDir = strings.TrimSpace(dir)
+	}
+}
+
+// WithEnv injects environment variables into every executed git command,
+// such as GIT_SSH_COMMAND or GIT_TERMINAL_PROMPT. Values will override any
+// existing environment variables with the same name. Environment variables
+// accumulate, allowing this option to be provided multiple times
+func WithEnv(kv map[string]string) ClientOption {
+	return func(c *Client) {
+		for k, v := range kv {
+			c.env = append(c.env, fmt.Sprintf("%s=%s", k, v))
+		}
+	}
 }
 
-// NewClient returns a new instance of the git client
-func NewClient() (*Client, error) {
-	c := &Client{}
+// WithTimeout limits the amount of time any git command can take to
+// execute. If exceeded, the command is cancelled and an [ErrGitTimeout]
+// error is returned. Any duration less than or equal to zero is ignored
+func WithTimeout(d time.Duration) ClientOption {
+	return func(c *Client) {
+		c.timeout = d
+	}
+}
+
+// NewClient returns a new instance of the git client. Options can be
+// provided to customize how the client executes git commands
+func NewClient(opts ...ClientOption) (*Client, error) {
+	c := &Client{gitBinary: defaultGitBinary}
+	for _, opt := range opts {
+		opt(c)
+	}
 
-	if _, err := c.Exec("type git"); err != nil {
-		return nil, ErrGitMissing{PathEnv: os.Getenv("PATH")}
+	if _, err := c.Exec(fmt.Sprintf("type '%s'", escapeQuotes(c.gitBinary))); err != nil {
+		return nil, ErrGitMissing{Binary: c.gitBinary, PathEnv: os.Getenv("PATH")}
 	}
 
 	c.gitVersion, _ = c.Exec("git --version")
@@ -177,6 +264,13 @@ func (c *Client) Repository() (Repository, error) {
 	}, nil
 }
 
+// CurrentBranch retrieves the name of the branch currently checked out
+// within the repository (working directory). An empty string is returned
+// if the repository is in a detached HEAD state
+func (c *Client) CurrentBranch() (string, error) {
+	return c.Exec("git branch --show-current")
+}
+
 // Exec supports the execution of any raw git command. No attempt will be
 // made to validate the command, and any output will be returned in its
 // raw unparsed form
@@ -184,22 +278,106 @@ func (c *Client) Exec(cmd string) (string, error) {
 	return c.internExec(cmd)
 }
 
-func (*Client) internExec(cmd string) (string, error) {
-	p, _ := syntax.NewParser().Parse(strings.NewReader(cmd), "")
+// ExecSeparate supports the execution of any raw git command, capturing
+// stdout and stderr separately. Git typically writes human readable
+// progress to stderr, allowing it to be distinguished from any results.
+// No attempt will be made to validate the command, and any output will be
+// returned in its raw unparsed form. On failure, any captured output is
+// returned alongside the error, with stderr also captured within the error
+func (c *Client) ExecSeparate(cmd string) (string, string, error) {
+	var stdout, stderr bytes.Buffer
+	err := c.run(cmd, &stdout, &stderr, &stderr)
+
+	return strings.TrimSuffix(stdout.String(), "\n"), strings.TrimSuffix(stderr.String(), "\n"), err
+}
+
+func (c *Client) internExec(cmd string) (string, error) {
+	var buf bytes.Buffer
+	if err := c.run(cmd, &buf, &buf, &buf); err != nil {
+		return "", err
+	}
 
+	return strings.TrimSuffix(buf.String(), "\n"), nil
+}
+
+func (c *Client) execWithStdin(cmd, stdin string) (string, error) {
 	var buf bytes.Buffer
-	r, _ := interp.New(
-		interp.StdIO(os.Stdin, &buf, &buf),
-	)
+	if err := c.runWithStdin(cmd, strings.NewReader(stdin), &buf, &buf, &buf); err != nil {
+		return "", err
+	}
+
+	return strings.TrimSuffix(buf.String(), "\n"), nil
+}
+
+func (c *Client) run(cmd string, stdout, stderr io.Writer, errOut *bytes.Buffer) error {
+	return c.runWithStdin(cmd, os.Stdin, stdout, stderr, errOut)
+}
+
+func (c *Client) runWithStdin(cmd string, stdin io.Reader, stdout, stderr io.Writer, errOut *bytes.Buffer) error {
+	return c.runContext(context.Background(), cmd, stdin, stdout, stderr, errOut)
+}
+
+func (c *Client) runContext(ctx context.Context, cmd string, stdin io.Reader, stdout, stderr io.Writer, errOut *bytes.Buffer) error {
+	p, _ := syntax.NewParser().Parse(strings.NewReader(cmd), "")
+
+	opts := []interp.RunnerOption{
+		interp.StdIO(stdin, stdout, stderr),
+		interp.ExecHandlers(c.gitHandler),
+	}
+
+	if len(c.env) > 0 {
+		opts = append(opts, interp.Env(expand.ListEnviron(append(os.Environ(), c.env...)...)))
+	}
+
+	r, _ := interp.New(opts...)
 
-	if err := r.Run(context.Background(), p); err != nil {
-		return "", ErrGitExecCommand{
+	if c.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.timeout)
+		defer cancel()
+	}
+
+	if err := r.Run(ctx, p); err != nil {
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return ErrGitTimeout{Cmd: cmd, Timeout: c.timeout}
+		}
+
+		execErr := ErrGitExecCommand{
 			Cmd: cmd,
-			Out: strings.TrimSuffix(buf.String(), "\n"),
+			Out: strings.TrimSuffix(errOut.String(), "\n"),
+		}
+		if status, ok := interp.IsExitStatus(err); ok {
+			execErr.ExitCode = int(status)
 		}
+		return execErr
 	}
 
-	return strings.TrimSuffix(buf.String(), "\n"), nil
+	return nil
+}
+
+// isExitCode identifies if an error was raised by the git client exiting
+// with the given exit code. Some git commands exit with a non-zero exit
+// code to signal an outcome, such as no matches being found
+func isExitCode(err error, code int) bool {
+	var execErr ErrGitExecCommand
+	return errors.As(err, &execErr) && execErr.ExitCode == code
+}
+
+// gitHandler ensures any invocation of git is handed-off to the configured
+// git binary and executed against the configured working directory
+func (c *Client) gitHandler(next interp.ExecHandlerFunc) interp.ExecHandlerFunc {
+	return func(ctx context.Context, args []string) error {
+		if args[0] != defaultGitBinary {
+			return next(ctx, args)
+		}
+
+		if c.workingDir != "" {
+			args = append([]string{args[0], "-C", c.workingDir}, args[1:]...)
+		}
+		args[0] = c.gitBinary
+
+		return next(ctx, args)
+	}
 }
 
 func (c *Client) rootDir() (string, error) {
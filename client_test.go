@@ -5,6 +5,7 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	git "github.com/purpleclay/gitz"
 	"github.com/purpleclay/gitz/gittest"
@@ -31,6 +32,123 @@ func TestNewClientGitMissingError(t *testing.T) {
 	assert.Nil(t, client)
 }
 
+func TestNewClientWithGitBinary(t *testing.T) {
+	shim := filepath.Join(t.TempDir(), "git-shim")
+	gittest.WriteFile(t, shim, "#!/bin/sh\necho 'git version 0.0.0-shim'\n", 0o755)
+
+	client, err := git.NewClient(git.WithGitBinary(shim))
+
+	require.NoError(t, err)
+	assert.Equal(t, "git version 0.0.0-shim", client.Version())
+}
+
+func TestNewClientWithGitBinaryQuotedPath(t *testing.T) {
+	shim := filepath.Join(t.TempDir(), "it's a git-shim")
+	gittest.WriteFile(t, shim, "#!/bin/sh\necho 'git version 0.0.0-shim'\n", 0o755)
+
+	client, err := git.NewClient(git.WithGitBinary(shim))
+
+	require.NoError(t, err)
+	assert.Equal(t, "git version 0.0.0-shim", client.Version())
+}
+
+func TestNewClientWithGitBinaryMissingError(t *testing.T) {
+	t.Setenv("PATH", "/fake")
+	missing := filepath.Join(t.TempDir(), "missing")
+
+	client, err := git.NewClient(git.WithGitBinary(missing))
+
+	require.ErrorAs(t, err, &git.ErrGitMissing{})
+	assert.EqualError(t, err, "git binary "+missing+" could not be found. PATH resolves to /fake")
+	assert.Nil(t, client)
+}
+
+func TestNewClientWithWorkingDirectory(t *testing.T) {
+	gittest.InitRepository(t, gittest.WithLocalCommits("feat: a change within the first repository"))
+	firstRepo := gittest.WorkingDirectory(t)
+
+	gittest.InitRepository(t, gittest.WithLocalCommits("feat: a change within the second repository"))
+	secondRepo := gittest.WorkingDirectory(t)
+
+	nonWorkingDirectory(t)
+
+	firstClient, _ := git.NewClient(git.WithWorkingDirectory(firstRepo))
+	firstLog, err := firstClient.Log()
+	require.NoError(t, err)
+
+	secondClient, _ := git.NewClient(git.WithWorkingDirectory(secondRepo))
+	secondLog, err := secondClient.Log()
+	require.NoError(t, err)
+
+	assert.Equal(t, "feat: a change within the first repository", firstLog.Commits[0].Message)
+	assert.Equal(t, "feat: a change within the second repository", secondLog.Commits[0].Message)
+}
+
+func TestNewClientWithEnv(t *testing.T) {
+	gittest.InitRepository(t)
+
+	client, _ := git.NewClient(git.WithEnv(map[string]string{
+		"GIT_AUTHOR_NAME":  "robin",
+		"GIT_AUTHOR_EMAIL": "robin@dc.com",
+	}))
+	out, err := client.Exec("git var GIT_AUTHOR_IDENT")
+
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(out, "robin <robin@dc.com>"))
+}
+
+func TestNewClientWithTimeout(t *testing.T) {
+	client, _ := git.NewClient(git.WithTimeout(50 * time.Millisecond))
+	_, err := client.Exec("sleep 5")
+
+	require.ErrorAs(t, err, &git.ErrGitTimeout{})
+	assert.EqualError(t, err, "git command timed out after 50ms: sleep 5")
+}
+
+func TestExecSeparate(t *testing.T) {
+	client, _ := git.NewClient()
+	stdout, stderr, err := client.ExecSeparate("git --version")
+
+	require.NoError(t, err)
+	assert.Equal(t, client.Version(), stdout)
+	assert.Empty(t, stderr)
+}
+
+func TestExecSeparateStderr(t *testing.T) {
+	gittest.InitRepository(t)
+
+	client, _ := git.NewClient()
+	stdout, stderr, err := client.ExecSeparate("git checkout -b feature")
+
+	require.NoError(t, err)
+	assert.Empty(t, stdout)
+	assert.Equal(t, "Switched to a new branch 'feature'", stderr)
+}
+
+func TestExecSeparateError(t *testing.T) {
+	gittest.InitRepository(t)
+
+	client, _ := git.NewClient()
+	stdout, stderr, err := client.ExecSeparate("git checkout does-not-exist")
+
+	var execErr git.ErrGitExecCommand
+	require.ErrorAs(t, err, &execErr)
+	assert.Empty(t, stdout)
+	assert.Contains(t, stderr, "did not match any file(s) known to git")
+	assert.Equal(t, stderr, execErr.Out)
+}
+
+func TestExecErrorExitCode(t *testing.T) {
+	gittest.InitRepository(t)
+
+	client, _ := git.NewClient()
+	_, err := client.Exec("git checkout does-not-exist")
+
+	var execErr git.ErrGitExecCommand
+	require.ErrorAs(t, err, &execErr)
+	assert.Equal(t, 1, execErr.ExitCode)
+}
+
 func TestRepository(t *testing.T) {
 	log := `(main) docs: include section on how to run with nix
 ci: extend workflow to patch default.nix file
@@ -130,6 +248,39 @@ func TestRepositoryWithMultipleRemotes(t *testing.T) {
 	assert.Equal(t, repo.Remotes["gitlab"], "git@gitlab.com:purpleclay/test.git")
 }
 
+func TestRepositoryWithRemoteName(t *testing.T) {
+	gittest.InitRepository(t, gittest.WithRemoteName("upstream"))
+
+	client, _ := git.NewClient()
+	repo, err := client.Repository()
+	require.NoError(t, err)
+
+	require.Len(t, repo.Remotes, 1)
+	assert.Equal(t, gittest.Remote(t), repo.Remotes["upstream"])
+}
+
+func TestCurrentBranch(t *testing.T) {
+	log := "(HEAD -> feature, main, origin/main) feat: a brand new feature"
+	gittest.InitRepository(t, gittest.WithLog(log))
+
+	client, _ := git.NewClient()
+	branch, err := client.CurrentBranch()
+
+	require.NoError(t, err)
+	assert.Equal(t, "feature", branch)
+}
+
+func TestCurrentBranchDetachedHead(t *testing.T) {
+	gittest.InitRepository(t)
+	gittest.Checkout(t, gittest.LastCommit(t).Hash)
+
+	client, _ := git.NewClient()
+	branch, err := client.CurrentBranch()
+
+	require.NoError(t, err)
+	assert.Empty(t, branch)
+}
+
 func TestToRelativePath(t *testing.T) {
 	gittest.InitRepository(t)
 	root := gittest.WorkingDirectory(t)
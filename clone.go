@@ -11,13 +11,26 @@ import (
 type CloneOption func(*cloneOptions)
 
 type cloneOptions struct {
+	Bare        bool
 	Config      []string
 	CheckoutRef string
 	Depth       int
 	Dir         string
+	Filter      string
+	Mirror      bool
 	NoTags      bool
 }
 
+// WithBare clones the repository as a bare repository, without a working
+// tree. The contents of the .git directory are written directly into the
+// cloned directory. Remote branches are copied as local branches, and no
+// remote-tracking branches are created
+func WithBare() CloneOption {
+	return func(opts *cloneOptions) {
+		opts.Bare = true
+	}
+}
+
 // WithCheckoutRef changes the default checkout behavior after a clone succeeds.
 // A branch or tag reference is supported. Checking out a tag will result in
 // a detached HEAD. An empty string will be ignored
@@ -58,6 +71,35 @@ func WithDirectory(dir string) CloneOption {
 	}
 }
 
+// WithFilter performs a partial clone of the repository, where objects
+// are omitted from the clone based on the provided filter spec, such as
+// blob:none or tree:0. Omitted objects are fetched from the remote on
+// demand. The remote must support partial clones. An empty string will
+// be ignored
+func WithFilter(spec string) CloneOption {
+	return func(opts *cloneOptions) {
+		opts.Filter = strings.TrimSpace(spec)
+	}
+}
+
+// WithBloblessClone performs a partial clone of the repository, where
+// no blobs (file contents) are downloaded upfront. All commits and trees
+// are cloned, with blobs fetched from the remote on demand. Shorthand for
+// WithFilter("blob:none")
+func WithBloblessClone() CloneOption {
+	return WithFilter("blob:none")
+}
+
+// WithMirror clones the repository as a mirror. A mirror is a bare
+// repository that maps all references within the remote, including
+// remote-tracking branches and notes, and keeps them in sync with the
+// remote on each fetch. Ideal for backup and migration
+func WithMirror() CloneOption {
+	return func(opts *cloneOptions) {
+		opts.Mirror = true
+	}
+}
+
 // WithNoTags prevents any tags from being included during the clone
 func WithNoTags() CloneOption {
 	return func(opts *cloneOptions) {
@@ -91,6 +133,12 @@ func (c *Client) Clone(url string, opts ...CloneOption) (string, error) {
 	}
 	buf.WriteString(" clone")
 
+	if options.Mirror {
+		buf.WriteString(" --mirror")
+	} else if options.Bare {
+		buf.WriteString(" --bare")
+	}
+
 	if options.NoTags {
 		buf.WriteString(" --no-tags")
 	}
@@ -105,6 +153,11 @@ func (c *Client) Clone(url string, opts ...CloneOption) (string, error) {
 		buf.WriteString(strconv.Itoa(options.Depth))
 	}
 
+	if options.Filter != "" {
+		buf.WriteString(" --filter=")
+		buf.WriteString(options.Filter)
+	}
+
 	buf.WriteString(" -- ")
 	buf.WriteString(url)
 
@@ -1,7 +1,9 @@
 package git_test
 
 import (
+	"fmt"
 	"os"
+	"strings"
 	"testing"
 
 	git "github.com/purpleclay/gitz"
@@ -169,3 +171,72 @@ func TestCloneWithNoTags(t *testing.T) {
 	require.NoError(t, os.Chdir(gittest.ClonedRepositoryName))
 	assert.Empty(t, gittest.Tags(t))
 }
+
+func TestCloneWithBloblessClone(t *testing.T) {
+	gittest.InitRepository(t)
+	gittest.TempFile(t, "file.txt", "version 1")
+	gittest.Exec(t, "git add file.txt && git commit -m 'chore: add first version of file'")
+	gittest.TempFile(t, "file.txt", "version 2")
+	gittest.Exec(t, "git commit -am 'chore: add second version of file' && git push")
+
+	// Partial clones must be explicitly supported by the remote
+	remote := gittest.Remote(t)
+	gittest.Exec(t, fmt.Sprintf("git -C %s config uploadpack.allowFilter true",
+		strings.TrimPrefix(remote, "file://")))
+
+	// Clone the existing repository into a new temporary directory
+	dir := t.TempDir()
+	require.NoError(t, os.Chdir(dir))
+
+	client, _ := git.NewClient()
+	_, err := client.Clone(remote, git.WithBloblessClone())
+
+	require.NoError(t, err)
+	require.NoError(t, os.Chdir(gittest.ClonedRepositoryName))
+
+	// Only the blob of the checked out file should have been fetched
+	objects := gittest.MustExec(t, "git rev-list --objects --missing=print --all")
+	assert.Equal(t, 1, strings.Count(objects, "?"))
+	assert.Equal(t, "true", gittest.MustExec(t, "git config remote.origin.promisor"))
+}
+
+func TestCloneWithBare(t *testing.T) {
+	gittest.InitRepository(t, gittest.WithCommittedFiles("file.txt"))
+	gittest.Exec(t, "git push")
+
+	// Grab the remote for cloning later
+	remote := gittest.Remote(t)
+
+	// Clone the existing repository into a new temporary directory
+	dir := t.TempDir()
+	require.NoError(t, os.Chdir(dir))
+
+	client, _ := git.NewClient()
+	_, err := client.Clone(remote, git.WithBare(), git.WithDirectory("bare-repo"))
+
+	require.NoError(t, err)
+	require.NoError(t, os.Chdir("bare-repo"))
+	assert.NoFileExists(t, "file.txt")
+	assert.Equal(t, "true", gittest.MustExec(t, "git rev-parse --is-bare-repository"))
+}
+
+func TestCloneWithMirror(t *testing.T) {
+	log := "(main, origin/main, origin/mirrored-branch) chore: test mirror clone"
+	gittest.InitRepository(t, gittest.WithLog(log))
+
+	// Grab the remote for cloning later
+	remote := gittest.Remote(t)
+
+	// Clone the existing repository into a new temporary directory
+	dir := t.TempDir()
+	require.NoError(t, os.Chdir(dir))
+
+	client, _ := git.NewClient()
+	_, err := client.Clone(remote, git.WithMirror(), git.WithDirectory("mirror-repo"))
+
+	require.NoError(t, err)
+	require.NoError(t, os.Chdir("mirror-repo"))
+	assert.Equal(t, "true", gittest.MustExec(t, "git rev-parse --is-bare-repository"))
+	assert.Equal(t, "true", gittest.MustExec(t, "git config remote.origin.mirror"))
+	assert.ElementsMatch(t, []string{"main", "mirrored-branch"}, gittest.Branches(t))
+}
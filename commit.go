@@ -2,7 +2,9 @@ package git
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
 )
 
 // CommitOption provides a way for setting specific options during a commit
@@ -12,10 +14,17 @@ type CommitOption func(*commitOptions)
 
 type commitOptions struct {
 	AllowEmpty    bool
+	Amend         bool
+	AmendNoEdit   bool
+	Author        string
+	Body          []string
 	Config        []string
+	Date          time.Time
 	ForceNoSigned bool
+	NoVerify      bool
 	Signed        bool
 	SigningKey    string
+	Trailers      []string
 }
 
 // WithAllowEmpty allows a commit to be created without having to track
@@ -27,6 +36,53 @@ func WithAllowEmpty() CommitOption {
 	}
 }
 
+// WithAmend replaces the tip of the current branch with a new commit,
+// rather than creating a new one. The existing commit message will be
+// replaced with the provided message
+func WithAmend() CommitOption {
+	return func(opts *commitOptions) {
+		opts.Amend = true
+	}
+}
+
+// WithAmendNoEdit replaces the tip of the current branch with a new commit,
+// rather than creating a new one. The existing commit message is preserved,
+// resulting in any provided message being ignored
+func WithAmendNoEdit() CommitOption {
+	return func(opts *commitOptions) {
+		opts.Amend = true
+		opts.AmendNoEdit = true
+	}
+}
+
+// WithAuthor overrides the author of the commit, without affecting the
+// committer. The author is typically resolved from the user.name and
+// user.email git config settings
+func WithAuthor(name, email string) CommitOption {
+	return func(opts *commitOptions) {
+		opts.Author = fmt.Sprintf("%s <%s>", strings.TrimSpace(name), strings.TrimSpace(email))
+	}
+}
+
+// WithCommitBody appends any number of paragraphs to the body of the commit
+// message. Each paragraph is separated from the subject and any other
+// paragraph by a blank line. All leading and trailing whitespace will be
+// trimmed from each paragraph, allowing empty paragraphs to be ignored
+func WithCommitBody(paragraphs ...string) CommitOption {
+	return func(opts *commitOptions) {
+		opts.Body = trim(paragraphs...)
+	}
+}
+
+// WithCommitDate overrides both the author and committer dates of the
+// commit. By default, git will use the current date and time. Dates are
+// passed to git using its internal format of <unix timestamp> <timezone>
+func WithCommitDate(date time.Time) CommitOption {
+	return func(opts *commitOptions) {
+		opts.Date = date
+	}
+}
+
 // WithCommitConfig allows temporary git config to be set during the
 // execution of the commit. Config set using this approach will override
 // any config defined within existing git config files. Config must be
@@ -69,6 +125,33 @@ func WithNoGpgSign() CommitOption {
 	}
 }
 
+// WithNoVerify bypasses both the pre-commit and commit-msg hooks during
+// the commit. Only hooks local to the current repository (working directory)
+// are bypassed
+func WithNoVerify() CommitOption {
+	return func(opts *commitOptions) {
+		opts.NoVerify = true
+	}
+}
+
+// WithTrailer appends a trailer, such as Signed-off-by or Co-authored-by,
+// to the end of the commit message. Trailers accumulate, allowing this
+// option to be provided multiple times. Any leading and trailing whitespace
+// will be trimmed from both the key and value. A trailer with an empty key
+// will be ignored
+//
+//	Co-authored-by: robin <robin@dc.com>
+func WithTrailer(key, value string) CommitOption {
+	return func(opts *commitOptions) {
+		key = strings.TrimSpace(key)
+		if key == "" {
+			return
+		}
+
+		opts.Trailers = append(opts.Trailers, fmt.Sprintf("%s: %s", key, strings.TrimSpace(value)))
+	}
+}
+
 // Commit a snapshot of changes within the current repository (working directory)
 // and describe those changes with a given log message. Commit behavior can be
 // customized through the use of options
@@ -84,6 +167,10 @@ func (c *Client) Commit(msg string, opts ...CommitOption) (string, error) {
 	}
 
 	var buf strings.Builder
+	if !options.Date.IsZero() {
+		date := fmt.Sprintf("%d %s", options.Date.Unix(), options.Date.Format("-0700"))
+		buf.WriteString(fmt.Sprintf("GIT_AUTHOR_DATE='%[1]s' GIT_COMMITTER_DATE='%[1]s' ", date))
+	}
 	buf.WriteString("git")
 
 	if len(cfg) > 0 {
@@ -96,6 +183,14 @@ func (c *Client) Commit(msg string, opts ...CommitOption) (string, error) {
 		buf.WriteString(" --allow-empty")
 	}
 
+	if options.Amend {
+		buf.WriteString(" --amend")
+	}
+
+	if options.Author != "" {
+		buf.WriteString(fmt.Sprintf(" --author='%s'", escapeQuotes(options.Author)))
+	}
+
 	if options.Signed {
 		buf.WriteString(" -S")
 	}
@@ -108,10 +203,100 @@ func (c *Client) Commit(msg string, opts ...CommitOption) (string, error) {
 		buf.WriteString(" --no-gpg-sign")
 	}
 
+	if options.NoVerify {
+		buf.WriteString(" --no-verify")
+	}
+
+	if options.AmendNoEdit {
+		buf.WriteString(" --no-edit")
+		return c.Exec(buf.String())
+	}
+
 	buf.WriteString(fmt.Sprintf(" -m '%s'", msg))
+	for _, paragraph := range options.Body {
+		buf.WriteString(fmt.Sprintf(" -m '%s'", escapeQuotes(paragraph)))
+	}
+
+	for _, trailer := range options.Trailers {
+		buf.WriteString(fmt.Sprintf(" --trailer '%s'", escapeQuotes(trailer)))
+	}
 	return c.Exec(buf.String())
 }
 
+// CommitResult contains details about a newly created commit
+type CommitResult struct {
+	// Hash contains the unique identifier associated with the commit
+	Hash string
+
+	// AbbrevHash contains the abbreviated commit hash. Its length is
+	// determined by git and will grow to ensure it remains unique
+	AbbrevHash string
+
+	// ShortStat contains a summary of the changes within the commit
+	ShortStat ShortStat
+}
+
+// ShortStat contains a summary of the changes made to files within
+// a repository
+type ShortStat struct {
+	// FilesChanged contains the number of files that have changed
+	FilesChanged int
+
+	// Insertions contains the number of lines that have been added
+	Insertions int
+
+	// Deletions contains the number of lines that have been removed
+	Deletions int
+}
+
+// CommitWithResult behaves identically to [Client.Commit], but returns
+// structured details about the newly created commit, rather than the
+// raw output from git. Details of the commit are retrieved using the
+// git command:
+//
+//	git show --shortstat --format='%H%x1f%h' HEAD
+func (c *Client) CommitWithResult(msg string, opts ...CommitOption) (CommitResult, error) {
+	if _, err := c.Commit(msg, opts...); err != nil {
+		return CommitResult{}, err
+	}
+
+	out, err := c.Exec("git show --shortstat --format='%H%x1f%h' HEAD")
+	if err != nil {
+		return CommitResult{}, err
+	}
+
+	hashes, stat, _ := strings.Cut(out, "\n")
+	hash, abbrevHash, _ := strings.Cut(hashes, "\x1f")
+	return CommitResult{
+		Hash:       hash,
+		AbbrevHash: abbrevHash,
+		ShortStat:  parseShortStat(stat),
+	}, nil
+}
+
+func parseShortStat(str string) ShortStat {
+	// Expected format: <n> file(s) changed, <n> insertion(s)(+), <n> deletion(s)(-)
+	var stat ShortStat
+	for _, part := range strings.Split(strings.TrimSpace(str), ", ") {
+		count, desc, found := strings.Cut(part, " ")
+		if !found {
+			continue
+		}
+
+		n, _ := strconv.Atoi(count)
+		switch {
+		case strings.HasPrefix(desc, "file"):
+			stat.FilesChanged = n
+		case strings.HasPrefix(desc, "insertion"):
+			stat.Insertions = n
+		case strings.HasPrefix(desc, "deletion"):
+			stat.Deletions = n
+		}
+	}
+
+	return stat
+}
+
 // CommitVerification contains details about a GPG signed commit
 type CommitVerification struct {
 	// Author represents a person who originally created the files
@@ -1,7 +1,11 @@
 package git_test
 
 import (
+	"os/exec"
+	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
 	git "github.com/purpleclay/gitz"
 	"github.com/purpleclay/gitz/gittest"
@@ -43,6 +47,50 @@ func TestCommitWithNoGpgSign(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestCommitWithGPGSigning(t *testing.T) {
+	keyID := gpgKey(t)
+	gittest.InitRepository(t, gittest.WithGPGSigning(keyID))
+
+	client, _ := git.NewClient()
+	_, err := client.Commit("this is a signed commit", git.WithAllowEmpty())
+	require.NoError(t, err)
+
+	_, err = client.VerifyCommit(gittest.LastCommit(t).Hash)
+	require.NoError(t, err)
+	assert.Equal(t, "G", gittest.MustExec(t, "git log -n1 --format=%G?"))
+}
+
+func gpgKey(t *testing.T) string {
+	t.Helper()
+	if _, err := exec.LookPath("gpg"); err != nil {
+		t.Skip("gpg is required to sign commits")
+	}
+
+	t.Setenv("GNUPGHOME", t.TempDir())
+	t.Cleanup(func() {
+		_ = exec.Command("gpgconf", "--kill", "gpg-agent").Run()
+	})
+
+	out, err := exec.Command("gpg", "--batch", "--passphrase", "", "--quick-gen-key",
+		gittest.DefaultAuthorLog, "ed25519", "sign", "never").CombinedOutput()
+	if err != nil {
+		t.Skipf("failed to generate gpg key: %s", out)
+	}
+
+	out, err = exec.Command("gpg", "--list-secret-keys", "--with-colons").Output()
+	require.NoError(t, err)
+
+	// The key ID is the fifth field of the secret key (sec) record
+	for _, line := range strings.Split(string(out), "\n") {
+		if fields := strings.Split(line, ":"); fields[0] == "sec" {
+			return fields[4]
+		}
+	}
+
+	t.Skip("no gpg signing key available")
+	return ""
+}
+
 func TestCommitWithCommitConfig(t *testing.T) {
 	gittest.InitRepository(t, gittest.WithStagedFiles("test.txt"))
 
@@ -55,3 +103,184 @@ func TestCommitWithCommitConfig(t *testing.T) {
 	assert.Equal(t, "bane", lastCommit.AuthorName)
 	assert.Equal(t, "bane@dc.com", lastCommit.AuthorEmail)
 }
+
+func TestCommitWithCommitBody(t *testing.T) {
+	gittest.InitRepository(t, gittest.WithStagedFiles("test.txt"))
+
+	client, _ := git.NewClient()
+	_, err := client.Commit("feat: a brand new feature",
+		git.WithCommitBody("this feature isn't like any other", "BREAKING CHANGE: everything has changed"))
+	require.NoError(t, err)
+
+	hash := gittest.LastCommit(t).Hash
+	commits, err := client.ShowCommits(hash)
+	require.NoError(t, err)
+
+	assert.Equal(t, `feat: a brand new feature
+
+this feature isn't like any other
+
+BREAKING CHANGE: everything has changed`, commits[hash].Message)
+}
+
+func TestCommitWithAmend(t *testing.T) {
+	gittest.InitRepository(t, gittest.WithStagedFiles("test.txt"))
+	gittest.Commit(t, "feat: a brand new feature")
+	before := gittest.LastCommit(t)
+	tree := gittest.MustExec(t, "git rev-parse HEAD^{tree}")
+
+	client, _ := git.NewClient()
+	_, err := client.Commit("feat: an amended feature", git.WithAmend())
+	require.NoError(t, err)
+
+	after := gittest.LastCommit(t)
+	assert.NotEqual(t, before.Hash, after.Hash)
+	assert.Equal(t, "feat: an amended feature", after.Message)
+	assert.Equal(t, tree, gittest.MustExec(t, "git rev-parse HEAD^{tree}"))
+}
+
+func TestCommitWithAmendNoEdit(t *testing.T) {
+	gittest.InitRepository(t, gittest.WithCommittedFiles("test.txt"))
+	before := gittest.LastCommit(t)
+	gittest.StagedFile(t, "another.txt", "another file")
+
+	client, _ := git.NewClient()
+	_, err := client.Commit("", git.WithAmendNoEdit())
+	require.NoError(t, err)
+
+	after := gittest.LastCommit(t)
+	assert.NotEqual(t, before.Hash, after.Hash)
+	assert.Equal(t, before.Message, after.Message)
+}
+
+func TestCommitWithAmendAllowEmpty(t *testing.T) {
+	gittest.InitRepository(t)
+
+	client, _ := git.NewClient()
+	_, err := client.Commit("chore: an empty amended commit", git.WithAmend(), git.WithAllowEmpty())
+	require.NoError(t, err)
+
+	assert.Equal(t, "chore: an empty amended commit", gittest.LastCommit(t).Message)
+}
+
+func TestCommitWithAuthor(t *testing.T) {
+	gittest.InitRepository(t, gittest.WithStagedFiles("test.txt"))
+
+	client, _ := git.NewClient()
+	_, err := client.Commit("feat: authored by someone else", git.WithAuthor("robin", "robin@dc.com"))
+	require.NoError(t, err)
+
+	hash := gittest.LastCommit(t).Hash
+	commits, err := client.ShowCommits(hash)
+	require.NoError(t, err)
+
+	commit := commits[hash]
+	assert.Equal(t, git.Person{Name: "robin", Email: "robin@dc.com"}, commit.Author)
+	assert.Equal(t, git.Person{Name: gittest.DefaultAuthorName, Email: gittest.DefaultAuthorEmail}, commit.Committer)
+}
+
+func TestCommitWithCommitDate(t *testing.T) {
+	gittest.InitRepository(t, gittest.WithStagedFiles("test.txt"))
+
+	date := time.Now().AddDate(-1, 0, 0)
+
+	client, _ := git.NewClient()
+	_, err := client.Commit("feat: imported from the past", git.WithCommitDate(date))
+	require.NoError(t, err)
+
+	hash := gittest.LastCommit(t).Hash
+	commits, err := client.ShowCommits(hash)
+	require.NoError(t, err)
+
+	assert.WithinDuration(t, date, commits[hash].AuthorDate, time.Second)
+	assert.WithinDuration(t, date, commits[hash].CommitterDate, time.Second)
+}
+
+func TestCommitWithTrailer(t *testing.T) {
+	gittest.InitRepository(t, gittest.WithStagedFiles("test.txt"))
+
+	client, _ := git.NewClient()
+	_, err := client.Commit("feat: a collaborative feature",
+		git.WithCommitBody("built together"),
+		git.WithTrailer("Signed-off-by", "batman <batman@dc.com>"),
+		git.WithTrailer("Co-authored-by", "robin <robin@dc.com>"))
+	require.NoError(t, err)
+
+	hash := gittest.LastCommit(t).Hash
+	commits, err := client.ShowCommits(hash)
+	require.NoError(t, err)
+
+	assert.Equal(t, `feat: a collaborative feature
+
+built together
+
+Signed-off-by: batman <batman@dc.com>
+Co-authored-by: robin <robin@dc.com>`, commits[hash].Message)
+}
+
+func TestCommitWithNoVerify(t *testing.T) {
+	gittest.InitRepository(t, gittest.WithStagedFiles("test.txt"))
+	failingHook(t, "pre-commit")
+
+	client, _ := git.NewClient()
+	_, err := client.Commit("feat: this should be blocked by the hook")
+	require.Error(t, err)
+
+	_, err = client.Commit("feat: this bypasses the hook", git.WithNoVerify())
+	require.NoError(t, err)
+	assert.Equal(t, "feat: this bypasses the hook", gittest.LastCommit(t).Message)
+}
+
+func failingHook(t *testing.T, name string) {
+	t.Helper()
+	gittest.WriteFile(t, filepath.Join(".git", "hooks", name), "#!/bin/sh\nexit 1\n", 0o755)
+}
+
+func TestCommitWithResult(t *testing.T) {
+	gittest.InitRepository(t,
+		gittest.WithCommittedFiles("main.go"),
+		gittest.WithFileContent("main.go", "package main\n\nfunc main() {}\n"))
+	overwriteFile(t, "main.go", "package main\n\nimport \"fmt\"\n\nfunc main() {\n\tfmt.Println(\"hello\")\n}\n")
+	gittest.StagedFile(t, "doc.go", "package main\n")
+	gittest.StageFile(t, "main.go")
+
+	client, _ := git.NewClient()
+	result, err := client.CommitWithResult("feat: print a friendly message")
+	require.NoError(t, err)
+
+	lastCommit := gittest.LastCommit(t)
+	assert.Equal(t, lastCommit.Hash, result.Hash)
+	assert.Equal(t, lastCommit.AbbrevHash, result.AbbrevHash)
+	assert.Equal(t, git.ShortStat{FilesChanged: 2, Insertions: 6, Deletions: 1}, result.ShortStat)
+}
+
+func TestCommitWithResultConfiguredAbbrev(t *testing.T) {
+	gittest.InitRepository(t, gittest.WithStagedFiles("main.go"))
+	gittest.ConfigSet(t, "core.abbrev", "12")
+
+	client, _ := git.NewClient()
+	result, err := client.CommitWithResult("feat: a longer abbreviated hash")
+	require.NoError(t, err)
+
+	assert.Len(t, result.AbbrevHash, 12)
+	assert.True(t, strings.HasPrefix(result.Hash, result.AbbrevHash))
+}
+
+func TestCommitWithResultEmptyCommit(t *testing.T) {
+	gittest.InitRepository(t)
+
+	client, _ := git.NewClient()
+	result, err := client.CommitWithResult("chore: nothing changed", git.WithAllowEmpty())
+	require.NoError(t, err)
+
+	assert.Equal(t, gittest.LastCommit(t).Hash, result.Hash)
+	assert.Equal(t, git.ShortStat{}, result.ShortStat)
+}
+
+func TestCommitWithResultError(t *testing.T) {
+	gittest.InitRepository(t)
+
+	client, _ := git.NewClient()
+	_, err := client.CommitWithResult("chore: nothing to commit")
+	require.Error(t, err)
+}
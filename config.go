@@ -1,9 +1,13 @@
 package git
 
 import (
+	"bufio"
 	"fmt"
+	"strconv"
 	"strings"
 	"unicode"
+
+	"github.com/purpleclay/gitz/scan"
 )
 
 // ErrInvalidConfigPath is raised when a config setting is to be accessed
@@ -51,17 +55,21 @@ func (e ErrMissingConfigValue) Error() string {
 // A map is returned containing each config item and its corresponding
 // latest value. Values are resolved from local, system and global config
 func (c *Client) Config() (map[string]string, error) {
-	cfg, err := c.Exec("git config --list")
+	cfg, err := c.Exec("git config --list --null")
 	if err != nil {
 		return nil, err
 	}
 
 	values := map[string]string{}
 
-	lines := strings.Split(cfg, "\n")
-	for _, line := range lines {
-		pos := strings.Index(line, "=")
-		values[line[:pos]] = line[pos+1:]
+	scanner := bufio.NewScanner(strings.NewReader(cfg))
+	scanner.Split(scan.NullTerminatedLines())
+
+	for scanner.Scan() {
+		// Each record is formatted as: <path>\n<value>\0. Values can contain
+		// both newlines and = characters, so only split on the first newline
+		path, value, _ := strings.Cut(scanner.Text(), "\n")
+		values[path] = value
 	}
 
 	return values, nil
@@ -118,6 +126,87 @@ func (c *Client) ConfigS(paths ...string) (map[string][]string, error) {
 	return c.configQuery("system", paths...)
 }
 
+// ConfigGetRegexpL attempts to query all local git config settings with
+// a path matching the provided regular expression. If multiple values have
+// been set for any config item, all are returned, ordered by most recent
+// value first. An empty map is returned if no settings match
+func (c *Client) ConfigGetRegexpL(pattern string) (map[string][]string, error) {
+	return c.configGetRegexp("local", pattern)
+}
+
+func (c *Client) configGetRegexp(location, pattern string) (map[string][]string, error) {
+	values := map[string][]string{}
+
+	cfg, err := c.Exec(fmt.Sprintf("git config --%s --null --get-regexp '%s'", location, escapeQuotes(pattern)))
+	if err != nil {
+		// Git exits with an exit code of 1 if there are no matches
+		if isExitCode(err, 1) {
+			return values, nil
+		}
+		return nil, err
+	}
+
+	scanner := bufio.NewScanner(strings.NewReader(cfg))
+	scanner.Split(scan.NullTerminatedLines())
+
+	for scanner.Scan() {
+		path, value, _ := strings.Cut(scanner.Text(), "\n")
+		values[path] = append([]string{value}, values[path]...)
+	}
+
+	return values, nil
+}
+
+// ConfigGetRegexpG attempts to query all global git config settings with
+// a path matching the provided regular expression. If multiple values have
+// been set for any config item, all are returned, ordered by most recent
+// value first. An empty map is returned if no settings match
+func (c *Client) ConfigGetRegexpG(pattern string) (map[string][]string, error) {
+	return c.configGetRegexp("global", pattern)
+}
+
+// ConfigGetRegexpS attempts to query all system git config settings with
+// a path matching the provided regular expression. If multiple values have
+// been set for any config item, all are returned, ordered by most recent
+// value first. An empty map is returned if no settings match
+func (c *Client) ConfigGetRegexpS(pattern string) (map[string][]string, error) {
+	return c.configGetRegexp("system", pattern)
+}
+
+// ConfigBool attempts to query a git config setting for its value as a
+// boolean. Git normalizes the value, ensuring values such as yes, on, true
+// and 1 are all treated as true. The value is resolved from local, system
+// and global config, with the latest value returned
+func (c *Client) ConfigBool(path string) (bool, error) {
+	value, err := c.configTyped("bool", path)
+	if err != nil {
+		return false, err
+	}
+
+	return value == "true", nil
+}
+
+func (c *Client) configTyped(typ, path string) (string, error) {
+	if err := CheckConfigPath(path); err != nil {
+		return "", err
+	}
+
+	return c.Exec(fmt.Sprintf("git config --type=%s --get %s", typ, path))
+}
+
+// ConfigInt attempts to query a git config setting for its value as an
+// integer. Git normalizes the value, ensuring any unit suffix, such as
+// k, m or g, is expanded. The value is resolved from local, system and
+// global config, with the latest value returned
+func (c *Client) ConfigInt(path string) (int, error) {
+	value, err := c.configTyped("int", path)
+	if err != nil {
+		return 0, err
+	}
+
+	return strconv.Atoi(value)
+}
+
 // ConfigSetL attempts to batch assign values to a group of local git
 // config settings. If any setting exists, a new line is added to the
 // local git config, effectively assigning multiple values to the same
@@ -170,6 +259,90 @@ func (c *Client) ConfigSetS(pairs ...string) error {
 	return c.configSet("system", pairs...)
 }
 
+// ConfigUnsetL attempts to remove a local git config setting. An error
+// is returned if the setting has been assigned multiple values, use
+// [Client.ConfigUnsetAllL] instead. The path is validated before any
+// attempt is made to remove the setting
+func (c *Client) ConfigUnsetL(path string) error {
+	return c.configUnset("local", "--unset", path)
+}
+
+func (c *Client) configUnset(location, flag, path string) error {
+	if err := CheckConfigPath(path); err != nil {
+		return err
+	}
+
+	_, err := c.Exec(fmt.Sprintf("git config --%s %s %s", location, flag, path))
+	return err
+}
+
+// ConfigUnsetG attempts to remove a global git config setting. An error
+// is returned if the setting has been assigned multiple values, use
+// [Client.ConfigUnsetAllG] instead. The path is validated before any
+// attempt is made to remove the setting
+func (c *Client) ConfigUnsetG(path string) error {
+	return c.configUnset("global", "--unset", path)
+}
+
+// ConfigUnsetS attempts to remove a system git config setting. An error
+// is returned if the setting has been assigned multiple values, use
+// [Client.ConfigUnsetAllS] instead. The path is validated before any
+// attempt is made to remove the setting
+func (c *Client) ConfigUnsetS(path string) error {
+	return c.configUnset("system", "--unset", path)
+}
+
+// ConfigUnsetAllL attempts to remove all values assigned to a local git
+// config setting. The path is validated before any attempt is made to
+// remove the setting
+func (c *Client) ConfigUnsetAllL(path string) error {
+	return c.configUnset("local", "--unset-all", path)
+}
+
+// ConfigUnsetAllG attempts to remove all values assigned to a global git
+// config setting. The path is validated before any attempt is made to
+// remove the setting
+func (c *Client) ConfigUnsetAllG(path string) error {
+	return c.configUnset("global", "--unset-all", path)
+}
+
+// ConfigUnsetAllS attempts to remove all values assigned to a system git
+// config setting. The path is validated before any attempt is made to
+// remove the setting
+func (c *Client) ConfigUnsetAllS(path string) error {
+	return c.configUnset("system", "--unset-all", path)
+}
+
+// ConfigRemoveSectionL attempts to remove an entire section from the local
+// git config, including all of its settings. A section is either a single
+// name, such as user, or a section and subsection pair, such as branch.main
+func (c *Client) ConfigRemoveSectionL(section string) error {
+	return c.configRemoveSection("local", section)
+}
+
+func (c *Client) configRemoveSection(location, section string) error {
+	if err := checkConfigSection(section); err != nil {
+		return err
+	}
+
+	_, err := c.Exec(fmt.Sprintf("git config --%s --remove-section '%s'", location, escapeQuotes(section)))
+	return err
+}
+
+// ConfigRemoveSectionG attempts to remove an entire section from the global
+// git config, including all of its settings. A section is either a single
+// name, such as user, or a section and subsection pair, such as branch.main
+func (c *Client) ConfigRemoveSectionG(section string) error {
+	return c.configRemoveSection("global", section)
+}
+
+// ConfigRemoveSectionS attempts to remove an entire section from the system
+// git config, including all of its settings. A section is either a single
+// name, such as user, or a section and subsection pair, such as branch.main
+func (c *Client) ConfigRemoveSectionS(section string) error {
+	return c.configRemoveSection("system", section)
+}
+
 // CheckConfigPath performs rudimentary checks to ensure the config path
 // conforms to the git config specification. A config path is invalid if:
 //
@@ -209,6 +382,32 @@ func CheckConfigPath(path string) error {
 	return nil
 }
 
+func checkConfigSection(section string) error {
+	// A subsection can contain any character, so only the section is checked
+	name, subsection, found := strings.Cut(section, ".")
+	if name == "" || (found && subsection == "") {
+		return ErrInvalidConfigPath{
+			Path:     section,
+			Position: -1,
+			Reason:   "section or subsection is empty",
+		}
+	}
+
+	for i, c := range name {
+		if unicode.IsDigit(c) || unicode.IsLetter(c) || c == '-' {
+			continue
+		}
+
+		return ErrInvalidConfigPath{
+			Path:     section,
+			Position: i,
+			Reason:   "non alphanumeric character detected in section [a-zA-Z0-9-]",
+		}
+	}
+
+	return nil
+}
+
 func checkConfig(pairs []string) error {
 	if len(pairs)%2 != 0 {
 		return ErrMissingConfigValue{Path: pairs[len(pairs)-1]}
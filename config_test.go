@@ -32,6 +32,19 @@ func TestConfigOnlyLatestValues(t *testing.T) {
 	assert.Equal(t, "scarecrow", cfg["user.name"])
 }
 
+func TestConfigValuesWithNewlines(t *testing.T) {
+	gittest.InitRepository(t)
+	gittest.MustExec(t, `git config --local alias.greet "!f() {
+  echo name=batman
+}; f"`)
+
+	client, _ := git.NewClient()
+	cfg, err := client.Config()
+
+	require.NoError(t, err)
+	assert.Equal(t, "!f() {\n  echo name=batman\n}; f", cfg["alias.greet"])
+}
+
 func TestConfigL(t *testing.T) {
 	gittest.InitRepository(t)
 	gittest.ConfigSet(t, "user.name", "alfred")
@@ -56,6 +69,72 @@ func configEquals(t *testing.T, path, expected string) {
 	assert.Equal(t, expected, cfg)
 }
 
+func TestConfigGetRegexpL(t *testing.T) {
+	gittest.InitRepository(t)
+	gittest.ConfigSet(t, "remote.upstream.url", "git@github.com:batman/gitz.git",
+		"remote.fork.url", "git@github.com:robin/gitz.git",
+		"remote.fork.url", "git@github.com:joker/gitz.git",
+		"remote.fork.pushurl", "git@github.com:penguin/gitz.git")
+
+	client, _ := git.NewClient()
+	cfg, err := client.ConfigGetRegexpL(`^remote\..*\.url$`)
+
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]string{
+		"remote.origin.url":   {gittest.Remote(t)},
+		"remote.upstream.url": {"git@github.com:batman/gitz.git"},
+		"remote.fork.url":     {"git@github.com:joker/gitz.git", "git@github.com:robin/gitz.git"},
+	}, cfg)
+}
+
+func TestConfigGetRegexpLNoMatches(t *testing.T) {
+	gittest.InitRepository(t)
+
+	client, _ := git.NewClient()
+	cfg, err := client.ConfigGetRegexpL(`^villain\.`)
+
+	require.NoError(t, err)
+	assert.Empty(t, cfg)
+}
+
+func TestConfigBool(t *testing.T) {
+	gittest.InitRepository(t)
+	gittest.ConfigSet(t, "user.hero", "yes")
+
+	client, _ := git.NewClient()
+	hero, err := client.ConfigBool("user.hero")
+	require.NoError(t, err)
+	assert.True(t, hero)
+
+	bare, err := client.ConfigBool("core.bare")
+	require.NoError(t, err)
+	assert.False(t, bare)
+}
+
+func TestConfigBoolInvalidValueError(t *testing.T) {
+	gittest.InitRepository(t)
+	gittest.ConfigSet(t, "user.hero", "maybe")
+
+	client, _ := git.NewClient()
+	_, err := client.ConfigBool("user.hero")
+
+	require.ErrorAs(t, err, &git.ErrGitExecCommand{})
+}
+
+func TestConfigInt(t *testing.T) {
+	gittest.InitRepository(t)
+	gittest.ConfigSet(t, "user.gadgets", "42", "user.villains", "1k")
+
+	client, _ := git.NewClient()
+	gadgets, err := client.ConfigInt("user.gadgets")
+	require.NoError(t, err)
+	assert.Equal(t, 42, gadgets)
+
+	villains, err := client.ConfigInt("user.villains")
+	require.NoError(t, err)
+	assert.Equal(t, 1024, villains)
+}
+
 func TestConfigSetL(t *testing.T) {
 	gittest.InitRepository(t)
 
@@ -95,6 +174,82 @@ func configMissing(t *testing.T, path string) {
 	require.Empty(t, cfg)
 }
 
+func TestConfigUnsetL(t *testing.T) {
+	gittest.InitRepository(t)
+	gittest.ConfigSet(t, "user.phobia", "bats")
+
+	client, _ := git.NewClient()
+	err := client.ConfigUnsetL("user.phobia")
+
+	require.NoError(t, err)
+	configMissing(t, "user.phobia")
+}
+
+func TestConfigUnsetAllL(t *testing.T) {
+	gittest.InitRepository(t)
+	gittest.ConfigSet(t, "user.phobia", "bats", "user.phobia", "clowns")
+
+	client, _ := git.NewClient()
+	err := client.ConfigUnsetAllL("user.phobia")
+	require.NoError(t, err)
+
+	_, err = client.ConfigL("user.phobia")
+	require.ErrorAs(t, err, &git.ErrGitExecCommand{})
+}
+
+func TestConfigUnsetLInvalidPathError(t *testing.T) {
+	gittest.InitRepository(t)
+
+	client, _ := git.NewClient()
+	err := client.ConfigUnsetL("user")
+
+	require.ErrorAs(t, err, &git.ErrInvalidConfigPath{})
+}
+
+func TestConfigRemoveSectionL(t *testing.T) {
+	gittest.InitRepository(t)
+	gittest.ConfigSet(t, "branch.feature/gadgets.remote", "origin",
+		"branch.feature/gadgets.merge", "refs/heads/feature/gadgets")
+
+	client, _ := git.NewClient()
+	err := client.ConfigRemoveSectionL("branch.feature/gadgets")
+
+	require.NoError(t, err)
+	configMissing(t, "branch.feature/gadgets.remote")
+	configMissing(t, "branch.feature/gadgets.merge")
+}
+
+func TestConfigRemoveSectionLInvalidSectionError(t *testing.T) {
+	tests := []struct {
+		name    string
+		section string
+		err     string
+	}{
+		{
+			name:    "Empty",
+			section: "",
+			err:     "path:  invalid as section or subsection is empty",
+		},
+		{
+			name:    "EmptySubsection",
+			section: "branch.",
+			err:     "path: branch. invalid as section or subsection is empty",
+		},
+		{
+			name:    "InvalidCharacter",
+			section: "bra_nch.main",
+			err:     "path: bra|_|nch.main invalid as non alphanumeric character detected in section [a-zA-Z0-9-]",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, _ := git.NewClient()
+			err := client.ConfigRemoveSectionL(tt.section)
+			assert.EqualError(t, err, tt.err)
+		})
+	}
+}
+
 func TestCheckConfigPathError(t *testing.T) {
 	tests := []struct {
 		name   string
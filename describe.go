@@ -0,0 +1,89 @@
+package git
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DescribeOption provides a way for setting specific options during a
+// describe operation. Each supported option can customize the way a
+// commit is described
+type DescribeOption func(*describeOptions)
+
+type describeOptions struct {
+	Abbrev     int
+	ExactMatch bool
+	Match      string
+	Tags       bool
+}
+
+// WithDescribeAbbrev changes the number of hexadecimal digits used when
+// abbreviating the commit hash within the description. An abbreviation
+// of zero will suppress the long format, only describing the closest tag.
+// Any number less than zero is ignored
+func WithDescribeAbbrev(n int) DescribeOption {
+	return func(opts *describeOptions) {
+		opts.Abbrev = n
+	}
+}
+
+// WithDescribeExactMatch will only describe a commit that has been
+// directly tagged. An error is returned if no tag points to the commit
+func WithDescribeExactMatch() DescribeOption {
+	return func(opts *describeOptions) {
+		opts.ExactMatch = true
+	}
+}
+
+// WithDescribeMatch will only consider tags that match the provided glob
+// pattern when describing a commit, for example v*. An empty string will
+// be ignored
+func WithDescribeMatch(pattern string) DescribeOption {
+	return func(opts *describeOptions) {
+		opts.Match = strings.TrimSpace(pattern)
+	}
+}
+
+// WithDescribeTags will consider all tags when describing a commit. By
+// default, only annotated tags are considered
+func WithDescribeTags() DescribeOption {
+	return func(opts *describeOptions) {
+		opts.Tags = true
+	}
+}
+
+// Describe generates a human-readable name for the current commit (HEAD)
+// based on the closest reachable tag. If the commit is tagged, only the
+// tag is returned. Otherwise, the tag is suffixed with the number of
+// commits made since the tag and the abbreviated hash of the commit,
+// in the format <tag>-<n>-g<hash>. An error is returned if no tag
+// can be found
+func (c *Client) Describe(opts ...DescribeOption) (string, error) {
+	options := &describeOptions{
+		Abbrev: disabledNumericOption,
+	}
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	var buf strings.Builder
+	buf.WriteString("git describe")
+
+	if options.Tags {
+		buf.WriteString(" --tags")
+	}
+
+	if options.Abbrev > disabledNumericOption {
+		buf.WriteString(fmt.Sprintf(" --abbrev=%d", options.Abbrev))
+	}
+
+	if options.Match != "" {
+		buf.WriteString(fmt.Sprintf(" --match '%s'", options.Match))
+	}
+
+	if options.ExactMatch {
+		buf.WriteString(" --exact-match")
+	}
+
+	return c.Exec(buf.String())
+}
@@ -0,0 +1,82 @@
+package git_test
+
+import (
+	"fmt"
+	"testing"
+
+	git "github.com/purpleclay/gitz"
+	"github.com/purpleclay/gitz/gittest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDescribe(t *testing.T) {
+	gittest.InitRepository(t)
+	gittest.Exec(t, "git tag -a 0.1.0 -m 'annotated tag'")
+
+	client, _ := git.NewClient()
+	desc, err := client.Describe()
+
+	require.NoError(t, err)
+	assert.Equal(t, "0.1.0", desc)
+}
+
+func TestDescribeAheadOfTag(t *testing.T) {
+	log := `feat: second commit since tag
+feat: first commit since tag
+(tag: 0.1.0) feat: tagged commit`
+	gittest.InitRepository(t, gittest.WithLog(log))
+
+	client, _ := git.NewClient()
+	desc, err := client.Describe(git.WithDescribeTags())
+
+	require.NoError(t, err)
+	assert.Equal(t, fmt.Sprintf("0.1.0-2-g%s", gittest.LastCommit(t).AbbrevHash), desc)
+}
+
+func TestDescribeWithDescribeAbbrev(t *testing.T) {
+	log := `feat: first commit since tag
+(tag: 0.1.0) feat: tagged commit`
+	gittest.InitRepository(t, gittest.WithLog(log))
+
+	client, _ := git.NewClient()
+	desc, err := client.Describe(git.WithDescribeTags(), git.WithDescribeAbbrev(10))
+	require.NoError(t, err)
+	assert.Equal(t, fmt.Sprintf("0.1.0-1-g%s", gittest.LastCommit(t).Hash[:10]), desc)
+
+	desc, err = client.Describe(git.WithDescribeTags(), git.WithDescribeAbbrev(0))
+	require.NoError(t, err)
+	assert.Equal(t, "0.1.0", desc)
+}
+
+func TestDescribeWithDescribeMatch(t *testing.T) {
+	log := `(tag: v0.2.0) feat: second tagged commit
+(tag: app/0.1.0) feat: first tagged commit`
+	gittest.InitRepository(t, gittest.WithLog(log))
+
+	client, _ := git.NewClient()
+	desc, err := client.Describe(git.WithDescribeTags(), git.WithDescribeMatch("app/*"))
+
+	require.NoError(t, err)
+	assert.Equal(t, fmt.Sprintf("app/0.1.0-1-g%s", gittest.LastCommit(t).AbbrevHash), desc)
+}
+
+func TestDescribeWithDescribeExactMatch(t *testing.T) {
+	log := `feat: untagged commit
+(tag: 0.1.0) feat: tagged commit`
+	gittest.InitRepository(t, gittest.WithLog(log))
+
+	client, _ := git.NewClient()
+	_, err := client.Describe(git.WithDescribeTags(), git.WithDescribeExactMatch())
+
+	require.ErrorAs(t, err, &git.ErrGitExecCommand{})
+}
+
+func TestDescribeNoTagsError(t *testing.T) {
+	gittest.InitRepository(t)
+
+	client, _ := git.NewClient()
+	_, err := client.Describe(git.WithDescribeTags())
+
+	require.ErrorAs(t, err, &git.ErrGitExecCommand{})
+}
@@ -2,6 +2,7 @@ package git
 
 import (
 	"bufio"
+	"fmt"
 	"strconv"
 	"strings"
 
@@ -16,6 +17,10 @@ const (
 	addPrefix = "+"
 	// prefix for lines removed
 	remPrefix = "-"
+	// prefix for unchanged lines of context
+	ctxPrefix = " "
+	// path used by git when a file doesn't exist on one side of a diff
+	devNull = "/dev/null"
 )
 
 // DiffOption provides a way for setting specific options during a diff
@@ -24,7 +29,65 @@ const (
 type DiffOption func(*diffOptions)
 
 type diffOptions struct {
-	DiffPaths []string
+	Context           int
+	DiffPaths         []string
+	ExcludePaths      []string
+	FromRef           string
+	IgnoreAllSpace    bool
+	IgnoreSpaceChange bool
+	Staged            bool
+	ToRef             string
+}
+
+func (o diffOptions) String() string {
+	var buf strings.Builder
+
+	if o.IgnoreAllSpace {
+		buf.WriteString(" --ignore-all-space")
+	}
+
+	if o.IgnoreSpaceChange {
+		buf.WriteString(" --ignore-space-change")
+	}
+
+	if o.Staged {
+		buf.WriteString(" --cached")
+	}
+
+	if o.FromRef != "" {
+		buf.WriteString(" ")
+		buf.WriteString(o.FromRef)
+	}
+
+	if o.ToRef != "" {
+		buf.WriteString(" ")
+		buf.WriteString(o.ToRef)
+	}
+
+	if len(o.DiffPaths) > 0 || len(o.ExcludePaths) > 0 {
+		buf.WriteString(" --")
+	}
+
+	if len(o.DiffPaths) > 0 {
+		buf.WriteString(" ")
+		buf.WriteString(strings.Join(o.DiffPaths, " "))
+	}
+
+	for _, path := range o.ExcludePaths {
+		buf.WriteString(fmt.Sprintf(" ':(exclude)%s'", escapeQuotes(path)))
+	}
+
+	return buf.String()
+}
+
+// WithContextLines includes a number of unchanged lines of context around
+// each change. Any context is included within both the added and removed
+// text of a [DiffChunk]. By default, no context is included. Any number
+// less than zero is ignored
+func WithContextLines(n int) DiffOption {
+	return func(opts *diffOptions) {
+		opts.Context = n
+	}
 }
 
 // WithDiffPaths allows the diff to be targeted to specific files and
@@ -38,12 +101,73 @@ func WithDiffPaths(paths ...string) DiffOption {
 	}
 }
 
+// WithDiffExcludePaths allows specific files and folders within the current
+// repository (working directory) to be excluded from the diff, such as any
+// generated files. Paths to files and folders are relative to the root of
+// the repository. Can be combined with [WithDiffPaths] to exclude paths from
+// within a targeted folder. All leading and trailing whitespace will be trimmed
+// from the file paths, allowing empty paths to be ignored
+func WithDiffExcludePaths(paths ...string) DiffOption {
+	return func(opts *diffOptions) {
+		opts.ExcludePaths = trim(paths...)
+	}
+}
+
+// WithDiffRefs changes the diff to compare the files between two references,
+// such as commits, branches or tags, rather than the working tree. If the
+// to reference is empty, the from reference will be compared against the
+// working tree. All leading and trailing whitespace will be trimmed from
+// the references, allowing empty references to be ignored
+func WithDiffRefs(from, to string) DiffOption {
+	return func(opts *diffOptions) {
+		opts.FromRef = strings.TrimSpace(from)
+		opts.ToRef = strings.TrimSpace(to)
+	}
+}
+
+// WithIgnoreAllSpace ignores all whitespace when comparing lines, ensuring
+// changes that only reformat a file, such as a change in indentation, are
+// not reported
+func WithIgnoreAllSpace() DiffOption {
+	return func(opts *diffOptions) {
+		opts.IgnoreAllSpace = true
+	}
+}
+
+// WithIgnoreSpaceChange ignores changes in the amount of whitespace when
+// comparing lines. Any whitespace at the end of a line is also ignored.
+// Unlike [WithIgnoreAllSpace], introducing whitespace where none existed
+// is still reported
+func WithIgnoreSpaceChange() DiffOption {
+	return func(opts *diffOptions) {
+		opts.IgnoreSpaceChange = true
+	}
+}
+
+// WithStaged changes the diff to compare any staged changes within the
+// index against HEAD, showing what is about to be committed. By default,
+// only unstaged changes within the working tree are retrieved
+func WithStaged() DiffOption {
+	return func(opts *diffOptions) {
+		opts.Staged = true
+	}
+}
+
 // FileDiff represents a snapshot containing all of the changes to
 // a file within a repository (working directory)
 type FileDiff struct {
 	// Path of the file within the repository (working directory)
 	Path string
 
+	// OldPath contains the previous path of the file if it has
+	// been renamed
+	OldPath string
+
+	// Similarity is the percentage of the file that remained
+	// unchanged after being renamed. Only set if a rename
+	// is detected
+	Similarity int
+
 	// DiffChunk contains all of the identified changes within
 	// the file
 	Chunks []DiffChunk
@@ -88,21 +212,175 @@ func (c *Client) Diff(opts ...DiffOption) ([]FileDiff, error) {
 		opt(options)
 	}
 
-	var buf strings.Builder
-	buf.WriteString("git diff -U0 --no-color")
-
-	if len(options.DiffPaths) > 0 {
-		buf.WriteString(" -- ")
-		buf.WriteString(strings.Join(options.DiffPaths, " "))
+	if options.Context < 0 {
+		options.Context = 0
 	}
 
-	out, err := c.Exec(buf.String())
+	out, err := c.Exec(fmt.Sprintf("git diff -U%d --no-color", options.Context) + options.String())
 	if err != nil {
 		return nil, err
 	}
 	return parseDiffs(out)
 }
 
+// FileChange represents a file that has changed within a repository,
+// without any details of the changes themselves
+type FileChange struct {
+	// Status of the file, for example, it may have been added,
+	// modified or renamed
+	Status FileStatusIndicator
+
+	// Path of the file within the repository (working directory)
+	Path string
+
+	// OldPath contains the previous path of the file if it has
+	// been renamed or copied
+	OldPath string
+}
+
+// DiffNameStatus identifies all files that have changed within the current
+// repository (working directory), along with their status. Details of the
+// changes themselves are not retrieved, making it a faster alternative to
+// [Client.Diff] for detecting changes. The same options as [Client.Diff]
+// are supported, except [WithContextLines]. Raw output is parsed from the
+// following command:
+//
+//	git diff --name-status -z --no-color
+func (c *Client) DiffNameStatus(opts ...DiffOption) ([]FileChange, error) {
+	options := &diffOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	out, err := c.Exec("git diff --name-status -z --no-color" + options.String())
+	if err != nil {
+		return nil, err
+	}
+
+	return parseNameStatus(out), nil
+}
+
+func parseNameStatus(out string) []FileChange {
+	var changes []FileChange
+
+	// <status>[<score>]\0<path>\0
+	// <status><score>\0<old path>\0<new path>\0
+	fields := strings.Split(out, "\x00")
+	for i := 0; i+1 < len(fields); i++ {
+		if fields[i] == "" {
+			continue
+		}
+
+		change := FileChange{
+			Status: FileStatusIndicator(fields[i][0]),
+			Path:   fields[i+1],
+		}
+		i++
+
+		if (change.Status == Renamed || change.Status == Copied) && i+1 < len(fields) {
+			change.OldPath = change.Path
+			change.Path = fields[i+1]
+			i++
+		}
+
+		changes = append(changes, change)
+	}
+
+	return changes
+}
+
+// DiffStatSummary contains a summary of all the changes made to files
+// within a repository (working directory)
+type DiffStatSummary struct {
+	// FilesChanged is the total number of files that have changed
+	FilesChanged int
+
+	// Insertions is the total number of lines added across all files
+	Insertions int
+
+	// Deletions is the total number of lines removed across all files
+	Deletions int
+
+	// Files contains a breakdown of the changes made to each file
+	Files []FileStat
+}
+
+// FileStat contains a summary of the changes made to an individual file
+// within a repository (working directory)
+type FileStat struct {
+	// Path of the file within the repository (working directory)
+	Path string
+
+	// OldPath contains the previous path of the file if it has
+	// been renamed
+	OldPath string
+
+	// Insertions is the number of lines added to the file
+	Insertions int
+
+	// Deletions is the number of lines removed from the file
+	Deletions int
+
+	// Binary identifies if the file is binary. Line counts are not
+	// reported for binary files
+	Binary bool
+}
+
+// DiffStat generates a summary of the changes made to files within the current
+// repository (working directory). The total number of lines inserted and deleted
+// is reported, along with a breakdown per file. The same options as [Client.Diff]
+// are supported, except [WithContextLines]. Raw output is parsed from the
+// following command:
+//
+//	git diff --numstat -z --no-color
+func (c *Client) DiffStat(opts ...DiffOption) (DiffStatSummary, error) {
+	options := &diffOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	out, err := c.Exec("git diff --numstat -z --no-color" + options.String())
+	if err != nil {
+		return DiffStatSummary{}, err
+	}
+
+	return parseNumStat(out), nil
+}
+
+func parseNumStat(out string) DiffStatSummary {
+	var summary DiffStatSummary
+
+	// <insertions>\t<deletions>\t<path>\0
+	// <insertions>\t<deletions>\t\0<old path>\0<new path>\0
+	fields := strings.Split(out, "\x00")
+	for i := 0; i < len(fields); i++ {
+		stat := strings.SplitN(fields[i], "\t", 3)
+		if len(stat) < 3 {
+			continue
+		}
+
+		file := FileStat{
+			Path:       stat[2],
+			Insertions: mustInt(stat[0]),
+			Deletions:  mustInt(stat[1]),
+			Binary:     stat[0] == "-",
+		}
+
+		if file.Path == "" && i+2 < len(fields) {
+			file.OldPath = fields[i+1]
+			file.Path = fields[i+2]
+			i += 2
+		}
+
+		summary.FilesChanged++
+		summary.Insertions += file.Insertions
+		summary.Deletions += file.Deletions
+		summary.Files = append(summary.Files, file)
+	}
+
+	return summary
+}
+
 func parseDiffs(log string) ([]FileDiff, error) {
 	var diffs []FileDiff
 
@@ -122,45 +400,94 @@ func parseDiffs(log string) ([]FileDiff, error) {
 }
 
 func parseDiff(diff string) (FileDiff, error) {
-	rem, path, err := diffPath()(diff)
+	rem, hdr, err := diffHeader(diff)
 	if err != nil {
 		return FileDiff{}, err
 	}
 
-	rem, _, err = chomp.Until(hdrDelim)(rem)
-	if err != nil {
-		return FileDiff{}, err
+	fileDiff := FileDiff{
+		Path:       hdr.Path,
+		OldPath:    hdr.OldPath,
+		Similarity: hdr.Similarity,
 	}
 
-	chunks, err := diffChunks(rem)
-	if err != nil {
+	// A diff may not contain any chunks, for example, a pure rename or
+	// the addition of an empty file
+	if rem == "" {
+		return fileDiff, nil
+	}
+
+	if fileDiff.Chunks, err = diffChunks(rem); err != nil {
 		return FileDiff{}, err
 	}
 
-	return FileDiff{
-		Path:   path,
-		Chunks: chunks,
-	}, nil
+	return fileDiff, nil
 }
 
-func diffPath() chomp.Combinator[string] {
-	return func(s string) (string, string, error) {
-		var rem string
-		var err error
+type diffHeaderInfo struct {
+	Path       string
+	OldPath    string
+	Similarity int
+}
 
-		if rem, _, err = chomp.Tag("diff --git ")(s); err != nil {
-			return rem, "", err
+// chomp restricts combinators to string based results, so the header is
+// parsed directly to preserve the names of each field
+func diffHeader(s string) (string, diffHeaderInfo, error) {
+	var rem string
+	var err error
+
+	if rem, _, err = chomp.Tag("diff --git ")(s); err != nil {
+		return rem, diffHeaderInfo{}, err
+	}
+
+	// diff --git a/<path> b/<path>
+	var hdr string
+	if rem, hdr, err = chomp.Eol()(rem); err != nil {
+		return rem, diffHeaderInfo{}, err
+	}
+	path := hdr[strings.LastIndex(hdr, " b/")+3:]
+
+	// Extended header lines are more reliable for identifying the path, as
+	// added and deleted files will reference /dev/null on one side
+	var oldPath, newPath, renamedFrom, similarity string
+	for rem != "" && !strings.HasPrefix(rem, hdrDelim) {
+		var line string
+		if rem, line, err = chomp.Eol()(rem); err != nil {
+			return rem, diffHeaderInfo{}, err
 		}
 
-		var path string
-		if rem, path, err = chomp.Until(" ")(rem); err != nil {
-			return rem, "", err
+		switch {
+		case strings.HasPrefix(line, "--- "):
+			oldPath = diffSidePath(line[4:])
+		case strings.HasPrefix(line, "+++ "):
+			newPath = diffSidePath(line[4:])
+		case strings.HasPrefix(line, "rename from "):
+			renamedFrom = line[12:]
+		case strings.HasPrefix(line, "rename to "):
+			path = line[10:]
+		case strings.HasPrefix(line, "similarity index "):
+			similarity = strings.TrimSuffix(line[17:], "%")
 		}
-		path = path[strings.Index(path, "/")+1:]
+	}
 
-		rem, _, err = chomp.Eol()(rem)
-		return rem, path, err
+	if newPath != "" {
+		path = newPath
+	} else if oldPath != "" {
+		path = oldPath
 	}
+
+	return rem, diffHeaderInfo{
+		Path:       path,
+		OldPath:    renamedFrom,
+		Similarity: mustInt(similarity),
+	}, nil
+}
+
+func diffSidePath(path string) string {
+	if path == devNull {
+		return ""
+	}
+	return path[strings.Index(path, "/")+1:]
 }
 
 func diffChunks(in string) ([]DiffChunk, error) {
@@ -220,25 +547,28 @@ func diffChunk() chomp.Combinator[[]string] {
 			return rem, nil, err
 		}
 
-		var removed string
-		rem, removed, err = chomp.Map(
-			chomp.ManyN(chomp.Prefixed(chomp.Eol(), chomp.Tag(remPrefix)), 0),
-			func(in []string) string { return strings.Join(in, "\n") },
-		)(rem)
-		if err != nil {
-			return rem, nil, err
-		}
+		// With context, unchanged lines are captured as part of both the
+		// removed and added text. This ensures each change reflects the
+		// line numbers and counts reported within the chunk header
+		var removed, added []string
+		for rem != "" && !strings.HasPrefix(rem, hdrDelim) {
+			var line string
+			if rem, line, err = chomp.Eol()(rem); err != nil {
+				return rem, nil, err
+			}
 
-		var added string
-		rem, added, err = chomp.Map(
-			chomp.ManyN(chomp.Prefixed(chomp.Eol(), chomp.Tag(addPrefix)), 0),
-			func(in []string) string { return strings.Join(in, "\n") },
-		)(rem)
-		if err != nil {
-			return rem, nil, err
+			switch {
+			case strings.HasPrefix(line, remPrefix):
+				removed = append(removed, line[1:])
+			case strings.HasPrefix(line, addPrefix):
+				added = append(added, line[1:])
+			case strings.HasPrefix(line, ctxPrefix):
+				removed = append(removed, line[1:])
+				added = append(added, line[1:])
+			}
 		}
 
-		return rem, append(changes, removed, added), nil
+		return rem, append(changes, strings.Join(removed, "\n"), strings.Join(added, "\n")), nil
 	}
 }
 
@@ -81,3 +81,305 @@ func TestDiffWithDiffPaths(t *testing.T) {
 
 	assert.Len(t, diffs, 1)
 }
+
+func TestDiffWithDiffExcludePaths(t *testing.T) {
+	gittest.InitRepository(t,
+		gittest.WithCommittedFiles("go.mod", "go.sum"),
+		gittest.WithFileContent("go.mod", "module gitz\n", "go.sum", "checksums\n"))
+
+	overwriteFile(t, "go.mod", "module github.com/purpleclay/gitz\n")
+	overwriteFile(t, "go.sum", "updated checksums\n")
+
+	client, _ := git.NewClient()
+	diffs, err := client.Diff(git.WithDiffExcludePaths("go.sum"))
+	require.NoError(t, err)
+
+	require.Len(t, diffs, 1)
+	assert.Equal(t, "go.mod", diffs[0].Path)
+}
+
+func TestDiffWithDiffRefs(t *testing.T) {
+	gittest.InitRepository(t,
+		gittest.WithCommittedFiles("version.txt"),
+		gittest.WithFileContent("version.txt", "version: 0.1.0\n"))
+	gittest.Tag(t, "0.1.0")
+
+	overwriteFile(t, "version.txt", "version: 0.2.0\n")
+	gittest.StageFile(t, "version.txt")
+	gittest.Commit(t, "chore: bump version")
+	gittest.Tag(t, "0.2.0")
+
+	client, _ := git.NewClient()
+	diffs, err := client.Diff()
+	require.NoError(t, err)
+	require.Empty(t, diffs)
+
+	diffs, err = client.Diff(git.WithDiffRefs("0.1.0", "0.2.0"))
+	require.NoError(t, err)
+
+	require.Len(t, diffs, 1)
+	assert.Equal(t, "version.txt", diffs[0].Path)
+	require.Len(t, diffs[0].Chunks, 1)
+	assert.Equal(t, "version: 0.1.0", diffs[0].Chunks[0].Removed.Change)
+	assert.Equal(t, "version: 0.2.0", diffs[0].Chunks[0].Added.Change)
+}
+
+func TestDiffWithStaged(t *testing.T) {
+	gittest.InitRepository(t,
+		gittest.WithCommittedFiles("file.txt"),
+		gittest.WithFileContent("file.txt", "Hello, World!\n"))
+
+	overwriteFile(t, "file.txt", "Goodbye, World!\n")
+	gittest.StageFile(t, "file.txt")
+
+	client, _ := git.NewClient()
+	diffs, err := client.Diff()
+	require.NoError(t, err)
+	assert.Empty(t, diffs)
+
+	diffs, err = client.Diff(git.WithStaged())
+	require.NoError(t, err)
+
+	require.Len(t, diffs, 1)
+	assert.Equal(t, "file.txt", diffs[0].Path)
+	require.Len(t, diffs[0].Chunks, 1)
+	assert.Equal(t, "Hello, World!", diffs[0].Chunks[0].Removed.Change)
+	assert.Equal(t, "Goodbye, World!", diffs[0].Chunks[0].Added.Change)
+}
+
+func TestDiffWithContextLines(t *testing.T) {
+	gittest.InitRepository(t,
+		gittest.WithCommittedFiles("file.txt"),
+		gittest.WithFileContent("file.txt", "line 1\nline 2\nline 3\nline 4\nline 5\nline 6\nline 7\n"))
+
+	overwriteFile(t, "file.txt", "line 1\nline 2\nline 3\nline four\nline 5\nline 6\nline 7\n")
+
+	client, _ := git.NewClient()
+	diffs, err := client.Diff(git.WithContextLines(3))
+	require.NoError(t, err)
+
+	require.Len(t, diffs, 1)
+	require.Len(t, diffs[0].Chunks, 1)
+
+	chunk := diffs[0].Chunks[0]
+	assert.Equal(t, 1, chunk.Removed.LineNo)
+	assert.Equal(t, 7, chunk.Removed.Count)
+	assert.Equal(t, "line 1\nline 2\nline 3\nline 4\nline 5\nline 6\nline 7", chunk.Removed.Change)
+	assert.Equal(t, 1, chunk.Added.LineNo)
+	assert.Equal(t, 7, chunk.Added.Count)
+	assert.Equal(t, "line 1\nline 2\nline 3\nline four\nline 5\nline 6\nline 7", chunk.Added.Change)
+}
+
+func TestDiffWithContextLinesMultipleChunks(t *testing.T) {
+	gittest.InitRepository(t,
+		gittest.WithCommittedFiles("file.txt"),
+		gittest.WithFileContent("file.txt", "1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n"))
+
+	overwriteFile(t, "file.txt", "one\n2\n3\n4\n5\n6\n7\n8\n9\nten\n")
+
+	client, _ := git.NewClient()
+	diffs, err := client.Diff(git.WithContextLines(1))
+	require.NoError(t, err)
+
+	require.Len(t, diffs, 1)
+	require.Len(t, diffs[0].Chunks, 2)
+	assert.Equal(t, "1\n2", diffs[0].Chunks[0].Removed.Change)
+	assert.Equal(t, "one\n2", diffs[0].Chunks[0].Added.Change)
+	assert.Equal(t, "9\n10", diffs[0].Chunks[1].Removed.Change)
+	assert.Equal(t, "9\nten", diffs[0].Chunks[1].Added.Change)
+}
+
+func TestDiffWithIgnoreAllSpace(t *testing.T) {
+	gittest.InitRepository(t,
+		gittest.WithCommittedFiles("main.go"),
+		gittest.WithFileContent("main.go", "func main() {\n\tprint()\n}\n"))
+	overwriteFile(t, "main.go", "func main() {\n        print()\n}\n")
+
+	client, _ := git.NewClient()
+	diffs, err := client.Diff()
+	require.NoError(t, err)
+	require.Len(t, diffs, 1)
+	assert.Len(t, diffs[0].Chunks, 1)
+
+	diffs, err = client.Diff(git.WithIgnoreAllSpace())
+	require.NoError(t, err)
+	assert.Empty(t, diffs)
+}
+
+func TestDiffWithIgnoreSpaceChange(t *testing.T) {
+	gittest.InitRepository(t,
+		gittest.WithCommittedFiles("main.go"),
+		gittest.WithFileContent("main.go", "func main() {\n\tprint()\n}\n"))
+	overwriteFile(t, "main.go", "func main() {\n\t\tprint()  \n}\n")
+
+	client, _ := git.NewClient()
+	diffs, err := client.Diff(git.WithIgnoreSpaceChange())
+	require.NoError(t, err)
+	assert.Empty(t, diffs)
+}
+
+func TestDiffAddedFile(t *testing.T) {
+	gittest.InitRepository(t)
+	gittest.StagedFile(t, "added.txt", "this file has been added\n")
+
+	client, _ := git.NewClient()
+	diffs, err := client.Diff(git.WithStaged())
+	require.NoError(t, err)
+
+	require.Len(t, diffs, 1)
+	assert.Equal(t, "added.txt", diffs[0].Path)
+	require.Len(t, diffs[0].Chunks, 1)
+	assert.Equal(t, "this file has been added", diffs[0].Chunks[0].Added.Change)
+	assert.Empty(t, diffs[0].Chunks[0].Removed.Change)
+}
+
+func TestDiffDeletedFile(t *testing.T) {
+	gittest.InitRepository(t,
+		gittest.WithCommittedFiles("deleted.txt"),
+		gittest.WithFileContent("deleted.txt", "this file will be deleted\n"))
+	gittest.Exec(t, "git rm deleted.txt")
+
+	client, _ := git.NewClient()
+	diffs, err := client.Diff(git.WithStaged())
+	require.NoError(t, err)
+
+	require.Len(t, diffs, 1)
+	assert.Equal(t, "deleted.txt", diffs[0].Path)
+	require.Len(t, diffs[0].Chunks, 1)
+	assert.Equal(t, "this file will be deleted", diffs[0].Chunks[0].Removed.Change)
+	assert.Empty(t, diffs[0].Chunks[0].Added.Change)
+}
+
+func TestDiffPureRename(t *testing.T) {
+	gittest.InitRepository(t,
+		gittest.WithCommittedFiles("renamed.txt"),
+		gittest.WithFileContent("renamed.txt", "this file will be renamed\n"))
+	gittest.Move(t, "renamed.txt", "moved.txt")
+
+	client, _ := git.NewClient()
+	diffs, err := client.Diff(git.WithStaged())
+	require.NoError(t, err)
+
+	require.Len(t, diffs, 1)
+	assert.Equal(t, "moved.txt", diffs[0].Path)
+	assert.Equal(t, "renamed.txt", diffs[0].OldPath)
+	assert.Equal(t, 100, diffs[0].Similarity)
+	assert.Empty(t, diffs[0].Chunks)
+}
+
+func TestDiffRenameWithChanges(t *testing.T) {
+	content := "line 1\nline 2\nline 3\nline 4\nline 5\nline 6\nline 7\nline 8\n"
+	gittest.InitRepository(t,
+		gittest.WithCommittedFiles("renamed.txt"),
+		gittest.WithFileContent("renamed.txt", content))
+	gittest.Move(t, "renamed.txt", "moved.txt")
+	overwriteFile(t, "moved.txt", content+"line 9\n")
+	gittest.StageFile(t, "moved.txt")
+
+	client, _ := git.NewClient()
+	diffs, err := client.Diff(git.WithStaged())
+	require.NoError(t, err)
+
+	require.Len(t, diffs, 1)
+	assert.Equal(t, "moved.txt", diffs[0].Path)
+	assert.Equal(t, "renamed.txt", diffs[0].OldPath)
+	assert.Greater(t, diffs[0].Similarity, 0)
+	assert.Less(t, diffs[0].Similarity, 100)
+
+	require.Len(t, diffs[0].Chunks, 1)
+	assert.Equal(t, "line 9", diffs[0].Chunks[0].Added.Change)
+}
+
+func TestDiffNameStatus(t *testing.T) {
+	gittest.InitRepository(t,
+		gittest.WithCommittedFiles("modified.txt", "renamed.txt", "deleted.txt"),
+		gittest.WithFileContent("renamed.txt", "this file will be renamed\n"))
+
+	overwriteFile(t, "modified.txt", "this file has been modified")
+	gittest.StagedFile(t, "added.txt", "this file has been added")
+	gittest.Move(t, "renamed.txt", "moved/renamed.txt")
+	gittest.Exec(t, "git rm deleted.txt")
+	gittest.StageFile(t, "modified.txt")
+
+	client, _ := git.NewClient()
+	changes, err := client.DiffNameStatus()
+	require.NoError(t, err)
+	assert.Empty(t, changes)
+
+	changes, err = client.DiffNameStatus(git.WithStaged())
+	require.NoError(t, err)
+
+	assert.ElementsMatch(t, []git.FileChange{
+		{Status: git.Added, Path: "added.txt"},
+		{Status: git.Deleted, Path: "deleted.txt"},
+		{Status: git.Modified, Path: "modified.txt"},
+		{Status: git.Renamed, Path: "moved/renamed.txt", OldPath: "renamed.txt"},
+	}, changes)
+}
+
+func TestDiffNameStatusWithDiffRefs(t *testing.T) {
+	gittest.InitRepository(t, gittest.WithCommittedFiles("file.txt"))
+	gittest.Tag(t, "0.1.0")
+	overwriteFile(t, "file.txt", "a change between tags")
+	gittest.StageFile(t, "file.txt")
+	gittest.Commit(t, "feat: change the file")
+
+	client, _ := git.NewClient()
+	changes, err := client.DiffNameStatus(git.WithDiffRefs("0.1.0", "HEAD"))
+	require.NoError(t, err)
+
+	assert.Equal(t, []git.FileChange{{Status: git.Modified, Path: "file.txt"}}, changes)
+}
+
+func TestDiffNameStatusSpecialCharacters(t *testing.T) {
+	gittest.InitRepository(t, gittest.WithCommittedFiles("old\tname.txt"))
+	gittest.StagedFile(t, "new\nfile.txt", "a file with a newline in its name")
+	gittest.Move(t, "old\tname.txt", "moved name.txt")
+
+	client, _ := git.NewClient()
+	changes, err := client.DiffNameStatus(git.WithStaged())
+	require.NoError(t, err)
+
+	assert.ElementsMatch(t, []git.FileChange{
+		{Status: git.Added, Path: "new\nfile.txt"},
+		{Status: git.Renamed, Path: "moved name.txt", OldPath: "old\tname.txt"},
+	}, changes)
+}
+
+func TestDiffStat(t *testing.T) {
+	gittest.InitRepository(t,
+		gittest.WithCommittedFiles("modified.txt", "renamed.txt"),
+		gittest.WithFileContent(
+			"modified.txt", "line 1\nline 2\nline 3\n",
+			"renamed.txt", "line 1\nline 2\nline 3\nline 4\nline 5\n"))
+
+	overwriteFile(t, "modified.txt", "line 1\nline two\nline 3\nline 4\n")
+	gittest.StagedFile(t, "added.txt", "line 1\nline 2\n")
+	gittest.Move(t, "renamed.txt", "moved.txt")
+	gittest.StageFile(t, "modified.txt")
+
+	client, _ := git.NewClient()
+	stat, err := client.DiffStat(git.WithStaged())
+	require.NoError(t, err)
+
+	assert.Equal(t, 3, stat.FilesChanged)
+	assert.Equal(t, 4, stat.Insertions)
+	assert.Equal(t, 1, stat.Deletions)
+	assert.ElementsMatch(t, []git.FileStat{
+		{Path: "added.txt", Insertions: 2},
+		{Path: "modified.txt", Insertions: 2, Deletions: 1},
+		{Path: "moved.txt", OldPath: "renamed.txt"},
+	}, stat.Files)
+}
+
+func TestDiffStatBinaryFile(t *testing.T) {
+	gittest.InitRepository(t)
+	gittest.StagedFile(t, "binary.dat", "\x00\x01\x02")
+
+	client, _ := git.NewClient()
+	stat, err := client.DiffStat(git.WithStaged())
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, stat.FilesChanged)
+	assert.Equal(t, []git.FileStat{{Path: "binary.dat", Binary: true}}, stat.Files)
+}
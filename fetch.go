@@ -17,6 +17,7 @@ type fetchOptions struct {
 	Force     bool
 	NoTags    bool
 	RefSpecs  []string
+	Remote    string
 	Tags      bool
 	Unshallow bool
 }
@@ -50,8 +51,15 @@ func (o fetchOptions) String() string {
 	}
 
 	if len(o.RefSpecs) > 0 {
-		buf.WriteString(" origin ")
+		remote := o.Remote
+		if remote == "" {
+			remote = "origin"
+		}
+
+		buf.WriteString(" " + remote + " ")
 		buf.WriteString(strings.Join(o.RefSpecs, " "))
+	} else if o.Remote != "" && !o.All {
+		buf.WriteString(" " + o.Remote)
 	}
 
 	return buf.String()
@@ -124,6 +132,16 @@ func WithFetchRefSpecs(refs ...string) FetchOption {
 	}
 }
 
+// WithFetchRemote allows the name of the remote to be changed when
+// fetching changes. By default, changes are fetched from origin, or
+// the remote tracked by the current branch. Ignored when used in
+// conjunction with the [WithAll] option
+func WithFetchRemote(name string) FetchOption {
+	return func(opts *fetchOptions) {
+		opts.Remote = strings.TrimSpace(name)
+	}
+}
+
 // WithUnshallow will fetch the complete history from the remote
 func WithUnshallow() FetchOption {
 	return func(opts *fetchOptions) {
@@ -93,6 +93,22 @@ func TestFetchWithFetchRefSpecs(t *testing.T) {
 	assert.Equal(t, "test: add test for validating refspecs", dlog[0].Message)
 }
 
+func TestFetchWithFetchRemote(t *testing.T) {
+	log := "(main, origin/main, upstream-branch) feat: ensure fetch supports a named remote"
+	gittest.InitRepository(t, gittest.WithLog(log))
+	bareRemote(t, "upstream")
+	gittest.Exec(t, "git push upstream upstream-branch")
+	gittest.Exec(t, "git branch -D upstream-branch")
+
+	client, _ := git.NewClient()
+	_, err := client.Fetch(git.WithFetchRemote("upstream"),
+		git.WithFetchRefSpecs("upstream-branch:upstream-branch"))
+	require.NoError(t, err)
+
+	assert.Contains(t, gittest.Branches(t), "upstream-branch")
+	assert.NotContains(t, gittest.MustExec(t, "git ls-remote --heads origin"), "refs/heads/upstream-branch")
+}
+
 func TestFetchWithUnshallow(t *testing.T) {
 	log := `(main, origin/main) fifth feature
 feat: fourth feature
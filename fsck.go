@@ -0,0 +1,87 @@
+package git
+
+import (
+	"errors"
+	"strings"
+)
+
+// FsckOption provides a way for setting specific options while verifying
+// the integrity of a repository. Each supported option can customize the
+// checks that are performed
+type FsckOption func(*fsckOptions)
+
+type fsckOptions struct {
+	NoReflogs bool
+}
+
+// WithFsckNoReflogs ensures commits only referenced by the reflog are not
+// considered reachable, allowing them to be reported as dangling
+func WithFsckNoReflogs() FsckOption {
+	return func(opts *fsckOptions) {
+		opts.NoReflogs = true
+	}
+}
+
+// FsckReport contains the outcome of verifying the integrity of the
+// objects within a repository
+type FsckReport struct {
+	// Dangling contains the hashes of all objects that are not referenced
+	// by any other object. These are not an indication of corruption
+	Dangling []string
+
+	// Missing contains the hashes of all objects that are referenced but
+	// do not exist within the repository, indicating corruption
+	Missing []string
+}
+
+// Fsck verifies the connectivity and validity of all objects within the
+// current repository (working directory). Details of any dangling or missing
+// objects are parsed into a report. If corruption is detected, the report
+// is returned alongside the error. Raw output is parsed from the following
+// command:
+//
+//	git fsck --no-progress
+func (c *Client) Fsck(opts ...FsckOption) (FsckReport, error) {
+	options := &fsckOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	var buf strings.Builder
+	buf.WriteString("git fsck --no-progress")
+
+	if options.NoReflogs {
+		buf.WriteString(" --no-reflogs")
+	}
+
+	out, err := c.Exec(buf.String())
+	if err != nil {
+		var execErr ErrGitExecCommand
+		if errors.As(err, &execErr) {
+			return parseFsck(execErr.Out), err
+		}
+		return FsckReport{}, err
+	}
+
+	return parseFsck(out), nil
+}
+
+func parseFsck(out string) FsckReport {
+	var report FsckReport
+	for _, line := range strings.Split(out, "\n") {
+		// Expected format of each line: <status> <type> <hash>
+		fields := strings.Fields(line)
+		if len(fields) != 3 {
+			continue
+		}
+
+		switch fields[0] {
+		case "dangling":
+			report.Dangling = append(report.Dangling, fields[2])
+		case "missing":
+			report.Missing = append(report.Missing, fields[2])
+		}
+	}
+
+	return report
+}
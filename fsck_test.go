@@ -0,0 +1,50 @@
+package git_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	git "github.com/purpleclay/gitz"
+	"github.com/purpleclay/gitz/gittest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFsck(t *testing.T) {
+	gittest.InitRepository(t, gittest.WithCommittedFiles("belt.txt"))
+
+	client, _ := git.NewClient()
+	report, err := client.Fsck()
+
+	require.NoError(t, err)
+	assert.Empty(t, report.Dangling)
+	assert.Empty(t, report.Missing)
+}
+
+func TestFsckWithFsckNoReflogs(t *testing.T) {
+	gittest.InitRepository(t, gittest.WithLocalCommits("feat: add utility belt"))
+	hash := gittest.LastCommit(t).Hash
+	gittest.MustExec(t, "git reset --hard HEAD~1")
+
+	client, _ := git.NewClient()
+	report, err := client.Fsck(git.WithFsckNoReflogs())
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{hash}, report.Dangling)
+}
+
+func TestFsckMissingObjects(t *testing.T) {
+	gittest.InitRepository(t, gittest.WithCommittedFiles("belt.txt"))
+	blob := gittest.ObjectRef(t, "belt.txt")
+
+	object := filepath.Join(".git", "objects", blob[:2], blob[2:])
+	require.NoError(t, os.Chmod(object, 0o600))
+	require.NoError(t, os.Remove(object))
+
+	client, _ := git.NewClient()
+	report, err := client.Fsck()
+
+	require.Error(t, err)
+	assert.Equal(t, []string{blob}, report.Missing)
+}
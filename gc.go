@@ -0,0 +1,58 @@
+package git
+
+import (
+	"fmt"
+	"strings"
+)
+
+// GCOption provides a way for setting specific options during garbage
+// collection. Each supported option can customize how the current
+// repository (working directory) is optimized
+type GCOption func(*gcOptions)
+
+type gcOptions struct {
+	Aggressive bool
+	Prune      string
+}
+
+// WithGCAggressive more aggressively optimizes the repository at the
+// expense of taking much more time to complete
+func WithGCAggressive() GCOption {
+	return func(opts *gcOptions) {
+		opts.Aggressive = true
+	}
+}
+
+// WithGCPrune prunes any loose objects older than the provided window,
+// overriding the default of two weeks. A window is expressed as a date,
+// for example, 1.week.ago, or now to prune all loose objects. All leading
+// and trailing whitespace will be trimmed, allowing an empty window to
+// be ignored
+func WithGCPrune(window string) GCOption {
+	return func(opts *gcOptions) {
+		opts.Prune = strings.TrimSpace(window)
+	}
+}
+
+// GC cleans up unnecessary files and optimizes the current repository
+// (working directory). Loose objects are compressed into packs and any
+// unreachable objects outside of the prune window are removed
+func (c *Client) GC(opts ...GCOption) (string, error) {
+	options := &gcOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	var buf strings.Builder
+	buf.WriteString("git gc --quiet")
+
+	if options.Aggressive {
+		buf.WriteString(" --aggressive")
+	}
+
+	if options.Prune != "" {
+		buf.WriteString(fmt.Sprintf(" --prune='%s'", options.Prune))
+	}
+
+	return c.Exec(buf.String())
+}
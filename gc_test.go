@@ -0,0 +1,32 @@
+package git_test
+
+import (
+	"testing"
+
+	git "github.com/purpleclay/gitz"
+	"github.com/purpleclay/gitz/gittest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGC(t *testing.T) {
+	gittest.InitRepository(t,
+		gittest.WithCommittedFiles("belt.txt", "gadgets/batarang.txt"),
+		gittest.WithLocalCommits("feat: add utility belt", "feat: add grappling hook"))
+
+	client, _ := git.NewClient()
+	_, err := client.GC()
+
+	require.NoError(t, err)
+	assert.Contains(t, gittest.MustExec(t, "git count-objects -v"), "count: 0")
+}
+
+func TestGCWithGCAggressiveAndPrune(t *testing.T) {
+	gittest.InitRepository(t, gittest.WithCommittedFiles("belt.txt"))
+
+	client, _ := git.NewClient()
+	_, err := client.GC(git.WithGCAggressive(), git.WithGCPrune("now"))
+
+	require.NoError(t, err)
+	assert.Contains(t, gittest.MustExec(t, "git count-objects -v"), "count: 0")
+}
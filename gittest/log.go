@@ -7,6 +7,13 @@ import (
 	"github.com/purpleclay/gitz/scan"
 )
 
+const (
+	// length of a hash generated using the SHA-1 object format
+	sha1HashLen = 40
+	// length of a hash generated using the SHA-256 object format
+	sha256HashLen = 64
+)
+
 // LogEntry represents a single log entry from the history
 // of a git repository
 type LogEntry struct {
@@ -67,8 +74,8 @@ type LogEntry struct {
 //
 //	git log --pretty='format:> %d %s%+b%-N'
 //
-// 3. A log containing an optional leading forty character hash. Can be used
-// in conjunction with both single line and multi-line formats:
+// 3. A log containing an optional leading forty (or sixty-four for SHA-256) character
+// hash. Can be used in conjunction with both single line and multi-line formats:
 //
 //	> b0d5429b967b9af0a0805fc2981b4420e10be38d (HEAD -> new-feature, origin/new-feature) pass tests
 //	> 58d708cb071df97e2561903aadcd4129419e9631 write tests for new feature
@@ -80,6 +87,10 @@ type LogEntry struct {
 //
 // [%m]: https://git-scm.com/docs/git-log#Documentation/git-log.txt-emmem
 func ParseLog(log string) []LogEntry {
+	return parseLog(log, DefaultBranch)
+}
+
+func parseLog(log, trunk string) []LogEntry {
 	if log == "" {
 		return nil
 	}
@@ -134,7 +145,7 @@ func ParseLog(log string) []LogEntry {
 					entry.Branches = append(entry.Branches, cleanedRef)
 
 					// Detect the existence of the default branch
-					if cleanedRef == DefaultBranch {
+					if cleanedRef == trunk {
 						entry.IsTrunk = true
 					}
 				}
@@ -149,7 +160,7 @@ func ParseLog(log string) []LogEntry {
 	for _, branch := range entries[0].Branches {
 		if hasBranchPrefix(branch, "HEAD->", "HEAD ->") {
 			if _, pointer, found := strings.Cut(branch, "->"); found {
-				head := strings.TrimSuffix(pointer, DefaultBranch)
+				head := strings.TrimSuffix(pointer, trunk)
 				entries[0].HeadPointerRef = strings.TrimSpace(head)
 				break
 			}
@@ -169,15 +180,19 @@ func hasBranchPrefix(branch string, prefixes ...string) bool {
 }
 
 func chompHash(str string) (string, string) {
-	if len(str) < 40 {
-		return "", str
+	// Count the leading hex characters to determine if a hash exists,
+	// supporting both SHA-1 (40) and SHA-256 (64) object formats
+	n := 0
+	for n < len(str) && isHex(str[n]) {
+		n++
 	}
 
-	hash := str[:40]
-	for _, b := range []byte(hash) {
-		if !(b >= '0' && b <= '9' || b >= 'a' && b <= 'f' || b >= 'A' && b <= 'F') {
-			return "", str
-		}
+	if n != sha1HashLen && n != sha256HashLen {
+		return "", str
 	}
-	return hash, str[40:]
+	return str[:n], str[n:]
+}
+
+func isHex(b byte) bool {
+	return b >= '0' && b <= '9' || b >= 'a' && b <= 'f' || b >= 'A' && b <= 'F'
 }
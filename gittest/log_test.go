@@ -85,6 +85,17 @@ func TestParseLogWithOptionalLeadingHash(t *testing.T) {
 	assert.Equal(t, "58d708c", entries[1].AbbrevHash)
 }
 
+func TestParseLogWithOptionalLeadingSHA256Hash(t *testing.T) {
+	log := `> 954237dd1e3f7f9e020d0556d3f92139a4eff6709a0ea33d8d64c1031b43ed1a feat: support sha-256 repositories`
+
+	entries := gittest.ParseLog(log)
+
+	require.Len(t, entries, 1)
+	assert.Equal(t, "954237dd1e3f7f9e020d0556d3f92139a4eff6709a0ea33d8d64c1031b43ed1a", entries[0].Hash)
+	assert.Equal(t, "954237d", entries[0].AbbrevHash)
+	assert.Equal(t, "feat: support sha-256 repositories", entries[0].Message)
+}
+
 func TestParseLogEmpty(t *testing.T) {
 	entries := gittest.ParseLog("")
 	assert.Empty(t, entries)
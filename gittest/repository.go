@@ -1,20 +1,13 @@
 package gittest
 
 import (
-	"bufio"
-	"bytes"
 	"context"
-	"errors"
-	"fmt"
 	"io/fs"
 	"os"
-	"path/filepath"
 	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
-	"mvdan.cc/sh/v3/interp"
-	"mvdan.cc/sh/v3/syntax"
 )
 
 const (
@@ -66,7 +59,7 @@ const (
 	ReadmeContent = "# Gitz Test Repository\n\n" + FileContent
 
 	// an internal template for pushing changes back to a remote origin
-	gitPushTemplate = "git push -u origin %s"
+	gitPushTemplate = "git push -u %s %s"
 )
 
 // RepositoryOption provides a utility for setting repository options during
@@ -75,13 +68,18 @@ const (
 type RepositoryOption func(*repositoryOptions)
 
 type repositoryOptions struct {
-	CloneDepth  int
-	CommitFiles bool
-	Commits     []string
-	FileContent map[string]string
-	Files       []file
-	Log         []LogEntry
-	RemoteLog   []LogEntry
+	Branches      []string
+	CloneDepth    int
+	CommitFiles   bool
+	Commits       []string
+	DefaultBranch string
+	FileContent   map[string]string
+	Files         []file
+	GPGSigningKey string
+	Log           string
+	RemoteLog     string
+	RemoteName    string
+	Tags          []string
 }
 
 type file struct {
@@ -131,7 +129,7 @@ type CommitDetails struct {
 //	git log --pretty='format:%d %s'
 func WithLog(log string) RepositoryOption {
 	return func(opts *repositoryOptions) {
-		opts.Log = ParseLog(log)
+		opts.Log = log
 	}
 }
 
@@ -159,7 +157,7 @@ func WithLog(log string) RepositoryOption {
 //	git log --pretty='format:%d %s'
 func WithRemoteLog(log string) RepositoryOption {
 	return func(opts *repositoryOptions) {
-		opts.RemoteLog = ParseLog(log)
+		opts.RemoteLog = log
 	}
 }
 
@@ -275,6 +273,78 @@ func WithCloneDepth(depth int) RepositoryOption {
 	}
 }
 
+// WithBranches ensures the repository will be initialized with a set of
+// local branches. Each branch is created at the initial commit of the
+// repository, without being checked out or pushed to the remote.
+//
+// For example:
+//
+//	gittest.InitRepository(t, gittest.WithBranches("feature", "fix"))
+//
+// This will result in a repository containing three local branches, with
+// the default branch remaining checked out:
+//
+//	$ git branch --list
+//	  feature
+//	  fix
+//	* main
+func WithBranches(names ...string) RepositoryOption {
+	return func(opts *repositoryOptions) {
+		opts.Branches = append(opts.Branches, names...)
+	}
+}
+
+// WithTags ensures the repository will be initialized with a set of
+// lightweight tags. Each tag is created at the initial commit of the
+// repository and pushed to the remote.
+//
+// For example:
+//
+//	gittest.InitRepository(t, gittest.WithTags("0.1.0", "v1"))
+//
+// This will result in both tags referencing the initial commit:
+//
+//	$ git log --oneline
+//	<HASH> (HEAD -> main, tag: v1, tag: 0.1.0, origin/main, origin/HEAD) initialized repository
+func WithTags(tags ...string) RepositoryOption {
+	return func(opts *repositoryOptions) {
+		opts.Tags = append(opts.Tags, tags...)
+	}
+}
+
+// WithGPGSigning ensures the repository will be configured to GPG sign
+// all commits and tags using the provided key ID. The key must exist
+// within the keyring of the user running the tests. The following config
+// will be written to the repository:
+//
+//	user.signingkey=<key-id>
+//	commit.gpgsign=true
+//	tag.gpgsign=true
+func WithGPGSigning(keyID string) RepositoryOption {
+	return func(opts *repositoryOptions) {
+		opts.GPGSigningKey = strings.TrimSpace(keyID)
+	}
+}
+
+// WithRemoteName ensures the repository will be cloned using the provided
+// name for its remote, rather than [DefaultOrigin]. Any remote branches
+// referenced within a log, see [WithLog] and [WithRemoteLog], must be
+// prefixed with this name
+func WithRemoteName(name string) RepositoryOption {
+	return func(opts *repositoryOptions) {
+		opts.RemoteName = strings.TrimSpace(name)
+	}
+}
+
+// WithDefaultBranchName ensures the repository will be initialized using
+// the provided name for its default branch, rather than [DefaultBranch].
+// This name is used by both the remote and local clone
+func WithDefaultBranchName(name string) RepositoryOption {
+	return func(opts *repositoryOptions) {
+		opts.DefaultBranch = strings.TrimSpace(name)
+	}
+}
+
 // InitRepository will attempt to initialize a test repository capable of
 // supporting any git operation. Options can be provided to customize the
 // initialization process, changing the default configuration used.
@@ -285,10 +355,13 @@ func WithCloneDepth(depth int) RepositoryOption {
 //  2. A shallow clone is made at the required clone depth
 //  3. Remote log history will be imported, creating a delta between
 //     the current repository (working directory) and the remote
-//  4. All local empty commits are made without pushing back to the remote
-//  5. All named files will be created and either staged or committed if
+//  4. All local branches and tags are created at the initial commit, with
+//     tags being pushed back to the remote
+//  5. GPG signing of commits and tags is configured
+//  6. All local empty commits are made without pushing back to the remote
+//  7. All named files will be created and either staged or committed if
 //     required
-//  6. Overwrites existing files with user-defined content.
+//  8. Overwrites existing files with user-defined content.
 //
 // Repository creation consists of two phases. First, a bare repository
 // is initialized, before being cloned locally. This ensures a fully
@@ -307,281 +380,59 @@ func InitRepository(t *testing.T, opts ...RepositoryOption) {
 	current, err := os.Getwd()
 	require.NoError(t, err)
 
-	// Generate two temporary directories. The first is initialized as a
-	// bare repository and becomes our filesystem based remote. The second
-	// is our working repository, which is a clone of the former
-	tmpDir := t.TempDir()
-	changeToDir(t, tmpDir)
-
-	Exec(t, fmt.Sprintf("git init --bare --initial-branch %s %s", DefaultBranch, BareRepositoryName))
-	setRemoteConfig(t, BareRepositoryName)
-	cloneRemoteAndInit(t, ClonedRepositoryName)
-
-	// Process any provided options to ensure repository is initialized as required
-	options := &repositoryOptions{}
-	for _, opt := range opts {
-		opt(options)
-	}
-
-	if len(options.Log) > 0 {
-		importLog(t, options.Log)
-	}
-
-	if options.CloneDepth > 0 {
-		// Remove the existing local clone and clone again specifying the depth
-		changeToDir(t, tmpDir)
-		require.NoError(t, os.RemoveAll(ClonedRepositoryName))
-		cloneRemoteAndInit(t, ClonedRepositoryName, fmt.Sprintf("--depth %d", options.CloneDepth))
-	}
-
-	// To ensure a successful delta is created, an additional clone is made of the
-	// bare (remote) repository. The remote log is then imported, ensuring the
-	// local clone is out of sync
-	if len(options.RemoteLog) > 0 {
-		localClone := changeToDir(t, tmpDir)
-		cloneRemoteAndInit(t, "remote-import")
-
-		importLog(t, options.RemoteLog)
-		require.NoError(t, os.Chdir(localClone))
-	}
-
-	for _, commit := range options.Commits {
-		Exec(t, fmt.Sprintf(`git commit --allow-empty -m "%s"`, commit))
-	}
-
-	if len(options.Files) > 0 {
-		for _, f := range options.Files {
-			content := FileContent
-			if fc, exists := options.FileContent[f.Path]; exists {
-				content = fc
-			}
-
-			TempFile(t, f.Path, content)
-			if f.Staged {
-				StageFile(t, f.Path)
-			}
-		}
-		if options.CommitFiles {
-			Commit(t, "include test files")
-		}
-	}
+	repo := InitRepositoryT(t, opts...)
+	require.NoError(t, os.Chdir(repo.Dir))
 
 	t.Cleanup(func() {
 		require.NoError(t, os.Chdir(current))
 	})
 }
 
-func changeToDir(t *testing.T, dir string) string {
-	changedFrom, err := os.Getwd()
-	require.NoError(t, err)
-
-	require.NoError(t, os.Chdir(dir))
-	return changedFrom
-}
-
-func setRemoteConfig(t *testing.T, dir string) {
-	currentDir := changeToDir(t, dir)
-	setConfig(t, "receive.advertisePushOptions", "true")
-	changeToDir(t, currentDir)
-}
-
-func cloneRemoteAndInit(t *testing.T, cloneName string, options ...string) {
-	MustExec(t, fmt.Sprintf("git clone %s file://$(pwd)/%s %s", strings.Join(options, " "), BareRepositoryName, cloneName))
-	require.NoError(t, os.Chdir(cloneName))
-
-	// Ensure author details are set
-	setConfig(t, "user.name", DefaultAuthorName)
-	setConfig(t, "user.email", DefaultAuthorEmail)
-
-	// Check if there any any commits, if not, initialize with readme and push back first commit
-	if out := MustExec(t, "git rev-list -n1 --all"); out == "" {
-		TempFile(t, "README.md", ReadmeContent)
-		StageFile(t, "README.md")
-
-		MustExec(t, fmt.Sprintf(`git commit -m "%s"`, InitialCommit))
-		MustExec(t, fmt.Sprintf(gitPushTemplate, DefaultBranch))
-	}
-
-	MustExec(t, "git remote set-head origin --auto")
+// returns a handle to the repository within the current working directory
+// of the test process
+func workingDir(t *testing.T) *TestRepository {
+	return &TestRepository{t: t}
 }
 
 // TempFile generates a temporary file with the given content at the provided
 // location within the file system. All directories will be created with permissions
 // of 0750 (drwxr-xr-x), and the file created with permissions of 0640 (-rw-r--r--)
 func TempFile(t *testing.T, path, content string) {
-	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o750))
-	require.NoError(t, os.WriteFile(path, []byte(content), 0o640))
-}
-
-func importLog(t *testing.T, log []LogEntry) {
-	// It is important to reverse the list as we want to write the log back
-	// to the repository in reverse chronological order
-	firstEntry := len(log) - 1
-	trunkIndex := 0
-
-	// If the latest commit contains both the HEAD pointer and trunk reference,
-	// just import without altering the trunk index. This condition is satisfied
-	// by a log line such as:
-	// (HEAD -> another-branch, main, origin/main) this is a commit
-	if log[0].IsTrunk && log[0].HeadPointerRef != "" {
-		goto process
-	}
-
-	// Shift the starting index of the trunk in relation to the head reference
-	for j := trunkIndex + 1; j <= firstEntry; j++ {
-		if log[j].IsTrunk {
-			trunkIndex = j
-			break
-		}
-	}
-
-process:
-	entry := firstEntry
-	for entry >= trunkIndex {
-		importLogEntry(t, log[entry])
-		entry--
-	}
-
-	if log[0].HeadPointerRef != "" {
-		// Since the HEAD pointer reference points at branch other than the default,
-		// checkout out the branch and continue import. The checkout must come before
-		// the import, since we import in reverse chronological order
-		MustExec(t, fmt.Sprintf("git checkout -b %s", log[0].HeadPointerRef))
-		for entry >= 0 {
-			importLogEntry(t, log[entry])
-			entry--
-		}
-	}
-}
-
-func importLogEntry(t *testing.T, entry LogEntry) {
-	// HACK:
-	// Flip the executable bit allowing the commit to be associated to the file
-	// without altering its contents
-	flipExecutableBit(t, "README.md")
-	StageFile(t, "README.md")
-	commitCmd := fmt.Sprintf(`git commit -m "%s"`, entry.Message)
-	MustExec(t, commitCmd)
-
-	// Grab the commit hash and use it when creating branches and tags
-	hash := MustExec(t, "git rev-parse HEAD")
-
-	importBranchesAtRef(t, entry.Branches, hash)
-	importTagsAtRef(t, entry.Tags, hash)
-}
-
-func importBranchesAtRef(t *testing.T, branches []string, ref string) {
-	if len(branches) == 0 {
-		return
-	}
-
-	// Track local and remote branches separately
-	local := map[string]struct{}{}
-	remote := map[string]struct{}{}
-
-	for _, branch := range branches {
-		// Filter out any branches that already exist, or are automatically updated
-		if branch == DefaultBranch ||
-			branch == DefaultRemoteBranchAlias ||
-			strings.HasPrefix(branch, "HEAD") {
-			continue
-		}
-
-		if strings.HasPrefix(branch, DefaultOrigin) {
-			remote[branch] = struct{}{}
-		} else {
-			local[branch] = struct{}{}
-		}
-	}
-
-	// Detect and push to the default remote branch if needed
-	if _, pushDefault := remote[DefaultRemoteBranch]; pushDefault {
-		MustExec(t, fmt.Sprintf(gitPushTemplate, DefaultBranch))
-		delete(remote, DefaultRemoteBranch)
-	}
-
-	for branch := range remote {
-		cleanedBranch := strings.TrimPrefix(branch, "origin/")
-
-		// Check if the branch already exists, before creating it
-		if out := MustExec(t, fmt.Sprintf("git branch --list %s", cleanedBranch)); out == "" {
-			MustExec(t, fmt.Sprintf("git branch %s %s", cleanedBranch, ref))
-		}
-		MustExec(t, fmt.Sprintf(gitPushTemplate, cleanedBranch))
-
-		if _, exists := local[cleanedBranch]; exists {
-			delete(local, cleanedBranch)
-		} else {
-			// Do not attempt to delete the branch locally if checked out
-			if current := MustExec(t, "git branch --show-current --no-color"); current != cleanedBranch {
-				MustExec(t, fmt.Sprintf("git branch -d %s", cleanedBranch))
-			}
-		}
-	}
-
-	for branch := range local {
-		MustExec(t, fmt.Sprintf("git branch %s %s", branch, ref))
-	}
-}
-
-func importTagsAtRef(t *testing.T, tags []string, ref string) {
-	if len(tags) == 0 {
-		return
-	}
-
-	for _, tag := range tags {
-		tagCmd := fmt.Sprintf("git tag %s %s", tag, ref)
-		MustExec(t, tagCmd)
-	}
-
-	MustExec(t, "git push --tags")
-}
-
-func flipExecutableBit(t *testing.T, path string) {
-	fi, err := os.Stat(path)
-	require.NoError(t, err, "README.md should exist")
-
-	perms := fi.Mode()
-	if perms&0o100 != 0 {
-		require.NoError(t, os.Chmod(path, perms&^0o100), "failed to turn on executable bit")
-	} else {
-		require.NoError(t, os.Chmod(path, perms|0o100), "failed to turn off executable bit")
-	}
-}
-
-func setConfig(t *testing.T, key, value string) {
-	configCmd := fmt.Sprintf(`git config %s "%s"`, key, value)
-	_, err := Exec(t, configCmd)
-	require.NoError(t, err)
+	t.Helper()
+	workingDir(t).TempFile(path, content)
 }
 
 // Exec will execute any given git command and return the raw output and
-// error from the underlying git client
+// error from the underlying git client. If the command fails, the error
+// will contain both the reason for exiting and all captured output
 func Exec(t *testing.T, cmd string) (string, error) {
 	t.Helper()
-	p, _ := syntax.NewParser().Parse(strings.NewReader(cmd), "")
-
-	var buf bytes.Buffer
-	r, _ := interp.New(
-		interp.StdIO(os.Stdin, &buf, &buf),
-	)
+	return workingDir(t).Exec(cmd)
+}
 
-	if err := r.Run(context.Background(), p); err != nil {
-		return "", errors.New(strings.TrimSuffix(buf.String(), "\n"))
-	}
+// ExecContext will execute any given git command, bound by the provided
+// context, and return the raw output and error from the underlying git
+// client. Execution stops if the context is cancelled or its deadline
+// is exceeded
+func ExecContext(t *testing.T, ctx context.Context, cmd string) (string, error) {
+	t.Helper()
+	return workingDir(t).ExecContext(ctx, cmd)
+}
 
-	return strings.TrimSuffix(buf.String(), "\n"), nil
+// ExecWithExitCode will execute any given git command and return the raw
+// output, exit code and error from the underlying git client. An exit code
+// of -1 is returned if the command failed without exiting, for example,
+// if it could not be started
+func ExecWithExitCode(t *testing.T, cmd string) (string, int, error) {
+	t.Helper()
+	return workingDir(t).ExecWithExitCode(cmd)
 }
 
 // MustExec will execute any given git command, requiring no failure. Any
 // raw output will be returned from the underlying git client
 func MustExec(t *testing.T, cmd string) string {
 	t.Helper()
-
-	out, err := Exec(t, cmd)
-	require.NoError(t, err)
-
-	return out
+	return workingDir(t).MustExec(cmd)
 }
 
 // ConfigSet will set any number of local git config items for the current
@@ -591,11 +442,7 @@ func MustExec(t *testing.T, cmd string) string {
 //	git config --add <path> '<value>'
 func ConfigSet(t *testing.T, pairs ...string) {
 	t.Helper()
-
-	require.Equal(t, len(pairs)%2, 0, "mismatch in number of config pairs")
-	for i := 0; i < len(pairs); i += 2 {
-		MustExec(t, fmt.Sprintf("git config --add %s '%s'", pairs[i], pairs[i+1]))
-	}
+	workingDir(t).ConfigSet(pairs...)
 }
 
 // Tags returns a list of all local tags associated with the current
@@ -604,13 +451,7 @@ func ConfigSet(t *testing.T, pairs ...string) {
 //	git for-each-ref refs/tags --format='%(refname:short)'
 func Tags(t *testing.T) []string {
 	t.Helper()
-	tags := MustExec(t, "git for-each-ref refs/tags --format='%(refname:short)'")
-
-	if tags == "" {
-		return nil
-	}
-
-	return strings.Split(tags, "\n")
+	return workingDir(t).Tags()
 }
 
 // RemoteTags returns a list of all tags that have been pushed to the
@@ -620,23 +461,14 @@ func Tags(t *testing.T) []string {
 //	git ls-remote --tags
 func RemoteTags(t *testing.T) []string {
 	t.Helper()
-	tagRefs := MustExec(t, "git ls-remote --tags")
-
-	tags := make([]string, 0)
-	for _, ref := range strings.Split(tagRefs, "\n") {
-		if _, tag, found := strings.Cut(ref, "refs/tags/"); found {
-			tags = append(tags, tag)
-		}
-	}
-
-	return tags
+	return workingDir(t).RemoteTags()
 }
 
 // WriteFile the given content to a file. If the file does not exist, it
 // will be created. Any existing file will be truncated
 func WriteFile(t *testing.T, path, content string, perm fs.FileMode) {
 	t.Helper()
-	require.NoError(t, os.WriteFile(path, []byte(content), perm))
+	workingDir(t).WriteFile(path, content, perm)
 }
 
 // StageFile will attempt to use the provided path to stage a file that
@@ -645,7 +477,7 @@ func WriteFile(t *testing.T, path, content string, perm fs.FileMode) {
 //	git add '<path>'
 func StageFile(t *testing.T, path string) {
 	t.Helper()
-	MustExec(t, fmt.Sprintf("git add '%s'", path))
+	workingDir(t).StageFile(path)
 }
 
 // StageAll will stage all changes to new and existing files, respecting
@@ -655,15 +487,14 @@ func StageFile(t *testing.T, path string) {
 //	git add -A
 func StageAll(t *testing.T) {
 	t.Helper()
-	MustExec(t, "git add -A")
+	workingDir(t).StageAll()
 }
 
 // StagedFile generates a temporary file with the given content and ensures
 // it is staged. A utility method that calls [TempFile] followed by [StageFile]
 func StagedFile(t *testing.T, path, content string) {
 	t.Helper()
-	TempFile(t, path, content)
-	StageFile(t, path)
+	workingDir(t).StagedFile(path, content)
 }
 
 // Move or rename a file within the current repository (working directory). The
@@ -672,9 +503,7 @@ func StagedFile(t *testing.T, path, content string) {
 //	git mv --force '<path>' '<to>'
 func Move(t *testing.T, path, to string) {
 	t.Helper()
-	require.NoError(t, os.MkdirAll(filepath.Dir(to), 0o750))
-
-	MustExec(t, fmt.Sprintf("git mv --force '%s' '%s'", path, to))
+	workingDir(t).Move(path, to)
 }
 
 // Commit a snapshot of all changes within the current repository (working directory)
@@ -684,7 +513,7 @@ func Move(t *testing.T, path, to string) {
 //	git commit -m '<message>'
 func Commit(t *testing.T, message string) {
 	t.Helper()
-	MustExec(t, fmt.Sprintf("git commit -m '%s'", message))
+	workingDir(t).Commit(message)
 }
 
 // CommitWithAuthor a snapshot of all changes within the current repository
@@ -695,7 +524,7 @@ func Commit(t *testing.T, message string) {
 //	git commit --author='name <email>' -m '<message>'
 func CommitWithAuthor(t *testing.T, name, email, message string) {
 	t.Helper()
-	MustExec(t, fmt.Sprintf("git commit --author='%s <%s>' -m '%s'", name, email, message))
+	workingDir(t).CommitWithAuthor(name, email, message)
 }
 
 // CommitEmpty allows a snapshot of the current repository (working directory) to be
@@ -705,7 +534,7 @@ func CommitWithAuthor(t *testing.T, name, email, message string) {
 //	git commit --allow-empty -m '<message>'
 func CommitEmpty(t *testing.T, message string) {
 	t.Helper()
-	MustExec(t, fmt.Sprintf("git commit --allow-empty -m '%s'", message))
+	workingDir(t).CommitEmpty(message)
 }
 
 // CommitEmptyWithAuthor allows a snapshot of the current repository (working directory)
@@ -715,7 +544,7 @@ func CommitEmpty(t *testing.T, message string) {
 //	git commit --allow-empty --author='name <email>' -m '<message>'
 func CommitEmptyWithAuthor(t *testing.T, name, email, message string) {
 	t.Helper()
-	MustExec(t, fmt.Sprintf("git commit --allow-empty --author='%s <%s>' -m '%s'", name, email, message))
+	workingDir(t).CommitEmptyWithAuthor(name, email, message)
 }
 
 // LastCommit returns the last commit from the git log of the current
@@ -724,26 +553,39 @@ func CommitEmptyWithAuthor(t *testing.T, name, email, message string) {
 //	git log -n1
 func LastCommit(t *testing.T) CommitDetails {
 	t.Helper()
+	return workingDir(t).LastCommit()
+}
 
-	log := MustExec(t, "git log -n1")
-	parts := strings.Split(log, "\n")
-
-	// The structure of a git log is incredibly stable, so follows the format:
+func parseCommitDetails(log string) CommitDetails {
+	// The structure of a git log follows the format below. Additional
+	// header lines may exist, such as a Merge: line for merge commits
+	// or details of a signature, so each line is identified by its label:
 	// commit <hash>
 	// Author: <name> <email>
 	// Date: <date>
 	// <blank>
 	// <tab><message>
+	var hash, author string
+	var message strings.Builder
 
-	hash := parts[0][7:47]
-	author := parts[1][8:]
-	authorName, authorEmail, _ := strings.Cut(author, " <")
+	header := true
+	for _, line := range strings.Split(log, "\n") {
+		if !header {
+			// A commit message can span multiple lines, so hoover everything else up
+			message.WriteString(strings.TrimSpace(line))
+			continue
+		}
 
-	// A commit message can span multiple lines, so hoover everything else up
-	var message strings.Builder
-	for _, line := range parts[4:] {
-		message.WriteString(strings.TrimSpace(line))
+		switch {
+		case strings.HasPrefix(line, "commit "):
+			hash = strings.Fields(line)[1]
+		case strings.HasPrefix(line, "Author: "):
+			author = strings.TrimSpace(strings.TrimPrefix(line, "Author: "))
+		case line == "":
+			header = false
+		}
 	}
+	authorName, authorEmail, _ := strings.Cut(author, " <")
 
 	return CommitDetails{
 		Hash:        hash,
@@ -761,13 +603,7 @@ func LastCommit(t *testing.T) CommitDetails {
 //	git status --porcelain
 func PorcelainStatus(t *testing.T) []string {
 	t.Helper()
-
-	status := MustExec(t, "git status --porcelain")
-	if status == "" {
-		return nil
-	}
-
-	return strings.Split(status, "\n")
+	return workingDir(t).PorcelainStatus()
 }
 
 // Log returns the log history of a repository (working directory) as
@@ -777,8 +613,7 @@ func PorcelainStatus(t *testing.T) []string {
 //	git log --pretty='format:> %H %d %s%+b%-N' main
 func Log(t *testing.T) []LogEntry {
 	t.Helper()
-	log := MustExec(t, fmt.Sprintf("git log --pretty='format:> %%H %%d %%s%%+b%%-N' %s", DefaultBranch))
-	return ParseLog(log)
+	return workingDir(t).Log()
 }
 
 // LogFor returns the log history of a repository (working directory)
@@ -789,13 +624,7 @@ func Log(t *testing.T) []LogEntry {
 //	git log --pretty='format:> %%H %%d %%s%%+b%%-N' -- '<path>' '<path>'
 func LogFor(t *testing.T, paths ...string) []LogEntry {
 	t.Helper()
-	var quotedPaths []string
-	for _, path := range paths {
-		quotedPaths = append(quotedPaths, fmt.Sprintf("'%s'", path))
-	}
-
-	log := MustExec(t, fmt.Sprintf("git log --pretty='format:> %%H %%d %%s%%+b%%-N' -- %s", strings.Join(quotedPaths, " ")))
-	return ParseLog(log)
+	return workingDir(t).LogFor(paths...)
 }
 
 // LogBetween returns the log history of a repository (working directory)
@@ -804,8 +633,7 @@ func LogFor(t *testing.T, paths ...string) []LogEntry {
 //	git log --pretty='format:> %%H %%d %%s%%+b%%-N' <from>..<to>
 func LogBetween(t *testing.T, from, to string) []LogEntry {
 	t.Helper()
-	log := MustExec(t, fmt.Sprintf("git log --pretty='format:> %%H %%d %%s%%+b%%-N' %s..%s", from, to))
-	return ParseLog(log)
+	return workingDir(t).LogBetween(from, to)
 }
 
 // RemoteLog returns the log history of a repository (working directory)
@@ -816,8 +644,7 @@ func LogBetween(t *testing.T, from, to string) []LogEntry {
 //	git log --pretty='format:> %H %d %s%+b%-N' origin/main
 func RemoteLog(t *testing.T) []LogEntry {
 	t.Helper()
-	log := MustExec(t, fmt.Sprintf("git log --pretty='format:> %%H %%d %%s%%+b%%-N' %s", DefaultRemoteBranch))
-	return ParseLog(log)
+	return workingDir(t).RemoteLog()
 }
 
 // Tag creates a lightweight tag that is only tracked locally and will not
@@ -827,7 +654,7 @@ func RemoteLog(t *testing.T) []LogEntry {
 //	git tag '<tag>'
 func Tag(t *testing.T, tag string) {
 	t.Helper()
-	MustExec(t, fmt.Sprintf("git tag '%s'", tag))
+	workingDir(t).Tag(tag)
 }
 
 // TagAnnotated creates an annotated tag that is only tracked locally and will
@@ -838,7 +665,7 @@ func Tag(t *testing.T, tag string) {
 //	git tag -a '<tag>' -m '<msg>'
 func TagAnnotated(t *testing.T, tag, msg string) {
 	t.Helper()
-	MustExec(t, fmt.Sprintf("git tag -a '%s' -m '%s'", tag, msg))
+	workingDir(t).TagAnnotated(tag, msg)
 }
 
 // TagRemote creates lightweight tag that is only tracked at the remote. This is achieved
@@ -850,9 +677,7 @@ func TagAnnotated(t *testing.T, tag, msg string) {
 //	git tag -d '<tag>'
 func TagRemote(t *testing.T, tag string) {
 	t.Helper()
-	Tag(t, tag)
-	MustExec(t, fmt.Sprintf("git push %s '%s'", DefaultOrigin, tag))
-	MustExec(t, fmt.Sprintf("git tag -d '%s'", tag))
+	workingDir(t).TagRemote(tag)
 }
 
 // Show will display information about a specific git object. The output
@@ -867,7 +692,7 @@ func TagRemote(t *testing.T, tag string) {
 //	git show '<object>'
 func Show(t *testing.T, object string) string {
 	t.Helper()
-	return MustExec(t, fmt.Sprintf("git show '%s'", object))
+	return workingDir(t).Show(object)
 }
 
 // Checkout will update the state of the repository (working directory)
@@ -884,7 +709,37 @@ func Show(t *testing.T, object string) string {
 //	git checkout '<object>'
 func Checkout(t *testing.T, object string) string {
 	t.Helper()
-	return MustExec(t, fmt.Sprintf("git checkout '%s'", object))
+	return workingDir(t).Checkout(object)
+}
+
+// Merge will merge the provided reference into the current branch of the
+// repository (working directory). A merge commit will always be created,
+// even if a fast-forward is possible. The following git command is executed:
+//
+//	git merge --no-ff --no-edit '<ref>'
+func Merge(t *testing.T, ref string) {
+	t.Helper()
+	workingDir(t).Merge(ref)
+}
+
+// Stash will record the current state of the repository (working directory)
+// and index within a new stash entry, reverting any local changes. The
+// following git command is executed:
+//
+//	git stash push
+func Stash(t *testing.T) {
+	t.Helper()
+	workingDir(t).Stash()
+}
+
+// StashWith will record the current state of the repository (working directory)
+// and index within a new stash entry, associated with the provided message.
+// Any local changes will be reverted. The following git command is executed:
+//
+//	git stash push -m '<message>'
+func StashWith(t *testing.T, message string) {
+	t.Helper()
+	workingDir(t).StashWith(message)
 }
 
 // Remote will retrieve the URL of the remote (typically origin) configured
@@ -897,10 +752,7 @@ func Checkout(t *testing.T, object string) string {
 //	git ls-remote --get-url
 func Remote(t *testing.T) string {
 	t.Helper()
-	remote := MustExec(t, "git ls-remote --get-url")
-
-	// Ensure path is escaped correctly when testing across different OS
-	return filepath.ToSlash(remote)
+	return workingDir(t).Remote()
 }
 
 // ShowBranch will retrieve the name of the current branch. Raw output is
@@ -909,7 +761,7 @@ func Remote(t *testing.T) string {
 //	git branch --show-current
 func ShowBranch(t *testing.T) string {
 	t.Helper()
-	return MustExec(t, "git branch --show-current")
+	return workingDir(t).ShowBranch()
 }
 
 // Branches returns a list of all local branches associated with the
@@ -918,13 +770,27 @@ func ShowBranch(t *testing.T) string {
 //	git branch --list --format='%(refname:short)'
 func Branches(t *testing.T) []string {
 	t.Helper()
-	branches := MustExec(t, "git branch --list --format='%(refname:short)'")
+	return workingDir(t).Branches()
+}
 
-	if branches == "" {
-		return nil
-	}
+// CreateBranch will create a new local branch from the current HEAD of
+// the repository, without checking it out. The following git command is
+// executed:
+//
+//	git branch '<name>'
+func CreateBranch(t *testing.T, name string) {
+	t.Helper()
+	workingDir(t).CreateBranch(name)
+}
 
-	return strings.Split(branches, "\n")
+// DeleteBranch will delete a local branch from the repository. The branch
+// must be fully merged into its upstream or the current HEAD. The following
+// git command is executed:
+//
+//	git branch -d '<name>'
+func DeleteBranch(t *testing.T, name string) {
+	t.Helper()
+	workingDir(t).DeleteBranch(name)
 }
 
 // RemoteBranches returns a list of all branches that have been pushed to
@@ -939,18 +805,7 @@ func Branches(t *testing.T) []string {
 //	git branch --list --remotes --format='%(refname:short)'
 func RemoteBranches(t *testing.T) []string {
 	t.Helper()
-	branches := MustExec(t, "git branch --list --remotes --format='%(refname:short)'")
-
-	if branches == "" {
-		return nil
-	}
-
-	cleanedBranches := make([]string, 0)
-	for _, branch := range strings.Split(branches, "\n") {
-		sep := strings.Index(branch, "/")
-		cleanedBranches = append(cleanedBranches, branch[sep+1:])
-	}
-	return cleanedBranches
+	return workingDir(t).RemoteBranches()
 }
 
 // WorkingDirectory returns the working directory (root) of the current
@@ -961,7 +816,7 @@ func RemoteBranches(t *testing.T) []string {
 //	git rev-parse --show-toplevel
 func WorkingDirectory(t *testing.T) string {
 	t.Helper()
-	return filepath.ToSlash(MustExec(t, "git rev-parse --show-toplevel"))
+	return workingDir(t).WorkingDirectory()
 }
 
 // ObjectRef scans the tree of the current repository for a an object identified
@@ -973,38 +828,7 @@ func WorkingDirectory(t *testing.T) string {
 //	git ls-tree <ref>
 func ObjectRef(t *testing.T, path string) string {
 	t.Helper()
-	require.NotEmpty(t, path)
-
-	fpath := filepath.ToSlash(path)
-	if fpath[0] == '/' {
-		fpath = fpath[1:]
-	}
-	require.NotEmpty(t, fpath, "path must contain more than a leading slash")
-
-	objectID := ""
-
-	// Initial parse of the git tree will always start from the HEAD
-	ref := "HEAD"
-	for _, fpart := range strings.Split(fpath, "/") {
-		tree := MustExec(t, "git ls-tree "+ref)
-
-		scanner := bufio.NewScanner(strings.NewReader(tree))
-		scanner.Split(bufio.ScanLines)
-
-		for scanner.Scan() {
-			// Expected format of each line (fixed widths):
-			// 100644 blob 672108528b5bdf1b1919f9f215149baae48d00e2    README.md
-			//             ^|12|                                  ^|42|
-			line := scanner.Text()
-			if strings.HasSuffix(line, fpart) {
-				ref = line[12:42]
-				objectID = ref
-				break
-			}
-		}
-	}
-
-	return objectID
+	return workingDir(t).ObjectRef(path)
 }
 
 // Blob retrieves the string representation of a blob within the git tree.
@@ -1014,11 +838,16 @@ func ObjectRef(t *testing.T, path string) string {
 //	git show -s <ref>
 func Blob(t *testing.T, path string) string {
 	t.Helper()
+	return workingDir(t).Blob(path)
+}
 
-	ref := ObjectRef(t, path)
-	if ref == "" {
-		return ""
-	}
-
-	return MustExec(t, "git show -s "+ref)
+// BlobAt retrieves the string representation of a file within the git tree
+// at the given reference. A reference can be any one of a commit (long or
+// abbreviated hash), tag or branch name. The content is retrieved using
+// this command:
+//
+//	git show '<ref>:<path>'
+func BlobAt(t *testing.T, ref, path string) string {
+	t.Helper()
+	return workingDir(t).BlobAt(ref, path)
 }
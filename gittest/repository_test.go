@@ -1,6 +1,7 @@
 package gittest_test
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"os/exec"
@@ -8,6 +9,7 @@ import (
 	"runtime"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/purpleclay/gitz/gittest"
 	"github.com/stretchr/testify/assert"
@@ -39,6 +41,67 @@ func TestInitRepositoryDefaultBranchSet(t *testing.T) {
 	assert.Equal(t, gittest.DefaultBranch, branch)
 }
 
+func TestInitRepositoryWithDefaultBranchName(t *testing.T) {
+	log := `(tag: 0.1.0, origin/trunk) feat: gadgets for the batmobile`
+	gittest.InitRepository(t,
+		gittest.WithDefaultBranchName("trunk"),
+		gittest.WithLog(log))
+
+	assert.Equal(t, "trunk", gittest.ShowBranch(t))
+
+	remoteLog := gittest.RemoteLog(t)
+	require.Len(t, remoteLog, 2)
+	assert.Equal(t, "feat: gadgets for the batmobile", remoteLog[0].Message)
+	assert.Contains(t, remoteLog[0].Branches, "origin/trunk")
+}
+
+func TestInitRepositoryWithRemoteName(t *testing.T) {
+	log := `(main, upstream/main) docs: document the batcave
+(upstream/feature) feat: gadgets for the batmobile`
+	gittest.InitRepository(t,
+		gittest.WithRemoteName("upstream"),
+		gittest.WithLog(log))
+	gittest.TagRemote(t, "0.1.0")
+
+	assert.Equal(t, "upstream", gitExec(t, "remote"))
+	assert.Contains(t, gittest.RemoteBranches(t), "feature")
+	assert.ElementsMatch(t, []string{"0.1.0"}, gittest.RemoteTags(t))
+
+	remoteLog := gittest.RemoteLog(t)
+	require.Len(t, remoteLog, 3)
+	assert.Equal(t, "docs: document the batcave", remoteLog[0].Message)
+}
+
+func TestInitRepositoryWithAdditionalRemote(t *testing.T) {
+	gittest.InitRepository(t)
+	gitExec(t, "commit", "--allow-empty", "-m", "this commit is on the remote")
+	gitExec(t, "push", gittest.DefaultOrigin, gittest.DefaultBranch)
+	addRemote(t, "fork")
+
+	gittest.TagRemote(t, "0.1.0")
+	assert.ElementsMatch(t, []string{"0.1.0"}, remoteTags(t))
+
+	remoteLog := gittest.RemoteLog(t)
+	require.Len(t, remoteLog, 2)
+	assert.Equal(t, "this commit is on the remote", remoteLog[0].Message)
+}
+
+func TestInitRepositoryWithRemoteNameAndAdditionalRemote(t *testing.T) {
+	gittest.InitRepository(t, gittest.WithRemoteName("upstream"))
+	addRemote(t, "fork")
+
+	gittest.TagRemote(t, "0.1.0")
+	assert.ElementsMatch(t, []string{"0.1.0"}, gittest.RemoteTags(t))
+	assert.Len(t, gittest.RemoteLog(t), 1)
+}
+
+func addRemote(t *testing.T, name string) {
+	t.Helper()
+	dir := t.TempDir()
+	gitExec(t, "init", "--bare", dir)
+	gitExec(t, "remote", "add", name, dir)
+}
+
 func TestInitRepositoryWithLog(t *testing.T) {
 	log := `chore: resolve broken build badge
 ci: adopt new code security workflow`
@@ -296,6 +359,41 @@ func TestExecReturnsClientError(t *testing.T) {
 	require.ErrorContains(t, err, "git: 'unknown' is not a git command")
 }
 
+func TestExecReturnsOutputOnError(t *testing.T) {
+	out, err := gittest.Exec(t, "echo 'gadgets' && git unknown")
+
+	require.ErrorContains(t, err, "exit status 1")
+	require.ErrorContains(t, err, "git: 'unknown' is not a git command")
+	assert.Contains(t, out, "gadgets")
+	assert.Contains(t, out, "git: 'unknown' is not a git command")
+}
+
+func TestExecWithExitCode(t *testing.T) {
+	_, code, err := gittest.ExecWithExitCode(t, "git rev-parse --verify unknown")
+
+	require.ErrorContains(t, err, "fatal: Needed a single revision")
+	assert.Equal(t, 128, code)
+}
+
+func TestExecWithExitCodeSuccess(t *testing.T) {
+	out, code, err := gittest.ExecWithExitCode(t, "git --version")
+
+	require.NoError(t, err)
+	assert.Equal(t, 0, code)
+	assert.Contains(t, out, "git version")
+}
+
+func TestExecContextCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	start := time.Now()
+	_, err := gittest.ExecContext(t, ctx, "sleep 5 && git --version")
+
+	require.Error(t, err)
+	assert.Less(t, time.Since(start), time.Second)
+}
+
 func TestMustExecHasRawGitOutput(t *testing.T) {
 	out := gittest.MustExec(t, "git --version")
 
@@ -412,6 +510,52 @@ func TestLastCommit(t *testing.T) {
 	assert.Equal(t, "this is a test", commit.Message)
 }
 
+func TestLastCommitSigned(t *testing.T) {
+	if _, err := exec.LookPath("ssh-keygen"); err != nil {
+		t.Skip("ssh-keygen is required to sign commits")
+	}
+
+	gittest.InitRepository(t)
+	key := filepath.Join(t.TempDir(), "id_ed25519")
+	out, err := exec.Command("ssh-keygen", "-q", "-t", "ed25519", "-N", "", "-f", key).CombinedOutput()
+	require.NoError(t, err, string(out))
+
+	gitExec(t, "config", "gpg.format", "ssh")
+	gitExec(t, "config", "user.signingkey", key+".pub")
+	gitExec(t, "config", "log.showSignature", "true")
+	gitExec(t, "commit", "-S", "--allow-empty", "-m", "this is a signed commit")
+	expectedHash := gitExec(t, "rev-parse", "HEAD")
+
+	commit := gittest.LastCommit(t)
+	assert.Equal(t, expectedHash, commit.Hash)
+	assert.Equal(t, gittest.DefaultAuthorName, commit.AuthorName)
+	assert.Equal(t, gittest.DefaultAuthorEmail, commit.AuthorEmail)
+	assert.Equal(t, "this is a signed commit", commit.Message)
+}
+
+func TestLastCommitUnicodeAuthor(t *testing.T) {
+	gittest.InitRepository(t)
+	gittest.CommitEmptyWithAuthor(t, "Zoë Ångström", "zoe@dc.com", "this is a test")
+
+	commit := gittest.LastCommit(t)
+	assert.Equal(t, "Zoë Ångström", commit.AuthorName)
+	assert.Equal(t, "zoe@dc.com", commit.AuthorEmail)
+	assert.Equal(t, "this is a test", commit.Message)
+}
+
+func TestLastCommitMerge(t *testing.T) {
+	gittest.InitRepository(t)
+	gitExec(t, "checkout", "-b", "feature")
+	gittest.CommitEmpty(t, "feat: a brand new feature")
+	gittest.Checkout(t, gittest.DefaultBranch)
+	gitExec(t, "merge", "--no-ff", "-m", "merge the brand new feature", "feature")
+
+	commit := gittest.LastCommit(t)
+	assert.Equal(t, gitExec(t, "rev-parse", "HEAD"), commit.Hash)
+	assert.Equal(t, gittest.DefaultAuthorName, commit.AuthorName)
+	assert.Equal(t, "merge the brand new feature", commit.Message)
+}
+
 func TestPorcelainStatus(t *testing.T) {
 	gittest.InitRepository(t, gittest.WithFiles("file1.txt", "file2.txt"))
 
@@ -584,6 +728,51 @@ func changeToTmpDir(t *testing.T) {
 	})
 }
 
+func TestCreateAndDeleteBranch(t *testing.T) {
+	gittest.InitRepository(t)
+
+	gittest.CreateBranch(t, "feature/gadgets")
+	assert.ElementsMatch(t, []string{"feature/gadgets", gittest.DefaultBranch}, gittest.Branches(t))
+	assert.Equal(t, gittest.DefaultBranch, gittest.ShowBranch(t))
+
+	gittest.DeleteBranch(t, "feature/gadgets")
+	assert.ElementsMatch(t, []string{gittest.DefaultBranch}, gittest.Branches(t))
+}
+
+func TestMerge(t *testing.T) {
+	gittest.InitRepository(t)
+	gittest.CreateBranch(t, "feature/gadgets")
+	gittest.Checkout(t, "feature/gadgets")
+	gittest.CommitEmpty(t, "feat: add grappling hook")
+	gittest.Checkout(t, gittest.DefaultBranch)
+
+	gittest.Merge(t, "feature/gadgets")
+
+	merges := gitExec(t, "rev-list", "--merges", "HEAD")
+	require.Len(t, strings.Split(merges, "\n"), 1)
+	assert.Equal(t, gitExec(t, "rev-parse", "HEAD"), merges)
+}
+
+func TestStash(t *testing.T) {
+	gittest.InitRepository(t, gittest.WithCommittedFiles("gadgets.txt"))
+	gittest.TempFile(t, "gadgets.txt", "grappling hook")
+
+	gittest.Stash(t)
+
+	assert.Empty(t, gittest.PorcelainStatus(t))
+	assert.Contains(t, gitExec(t, "stash", "list"), "stash@{0}")
+}
+
+func TestStashWith(t *testing.T) {
+	gittest.InitRepository(t, gittest.WithCommittedFiles("gadgets.txt"))
+	gittest.TempFile(t, "gadgets.txt", "grappling hook")
+
+	gittest.StashWith(t, "wip: utility belt")
+
+	assert.Empty(t, gittest.PorcelainStatus(t))
+	assert.Contains(t, gitExec(t, "stash", "list"), "wip: utility belt")
+}
+
 func TestRemoteBranches(t *testing.T) {
 	gittest.InitRepository(t)
 
@@ -611,6 +800,20 @@ func TestRemoteBranchesOnInitializedRepository(t *testing.T) {
 	assert.Empty(t, branches)
 }
 
+func TestBlobAt(t *testing.T) {
+	gittest.InitRepository(t)
+	gittest.StagedFile(t, "gadgets/belt.txt", "grappling hook")
+	gittest.Commit(t, "feat: add utility belt")
+	gittest.Tag(t, "0.1.0")
+
+	gittest.StagedFile(t, "gadgets/belt.txt", "grappling hook\nbatarang")
+	gittest.Commit(t, "feat: add batarang to utility belt")
+	gittest.Tag(t, "0.2.0")
+
+	assert.Equal(t, "grappling hook", gittest.BlobAt(t, "0.1.0", "gadgets/belt.txt"))
+	assert.Equal(t, "grappling hook\nbatarang", gittest.BlobAt(t, "0.2.0", "gadgets/belt.txt"))
+}
+
 func TestObjectRef(t *testing.T) {
 	gittest.InitRepository(t)
 	gittest.TempFile(t, "a/b/file.txt", gittest.FileContent)
@@ -619,5 +822,45 @@ func TestObjectRef(t *testing.T) {
 
 	ref := gittest.ObjectRef(t, "a/b/file.txt")
 	// Blob IDs are computed using the SHA-1 hash of the file contents (so remains constant)
-	assert.Equal(t, "08e00ed29169d1c8876c8d593fc2d6", ref)
+	assert.Equal(t, "08e00ed29169d1c8876c8d593fc2d675df3b61bf", ref)
+}
+
+func TestObjectRefSHA256(t *testing.T) {
+	dir := t.TempDir()
+	current, err := os.Getwd()
+	require.NoError(t, err)
+	require.NoError(t, os.Chdir(dir))
+	t.Cleanup(func() {
+		require.NoError(t, os.Chdir(current))
+	})
+
+	gitExec(t, "init", "--object-format=sha256")
+	gitExec(t, "config", "user.name", gittest.DefaultAuthorName)
+	gitExec(t, "config", "user.email", gittest.DefaultAuthorEmail)
+	gittest.TempFile(t, "a/b/file.txt", gittest.FileContent)
+	gitExec(t, "add", "a/b/file.txt")
+	gitExec(t, "commit", "-m", "chore: add nested file")
+
+	ref := gittest.ObjectRef(t, "a/b/file.txt")
+	assert.Len(t, ref, 64)
+	assert.Equal(t, gittest.FileContent, gittest.Blob(t, "a/b/file.txt"))
+
+	entries := gittest.ParseLog(gitExec(t, "log", "--pretty=format:> %H %d %s%+b%-N"))
+	require.Len(t, entries, 1)
+	assert.Len(t, entries[0].Hash, 64)
+	assert.Equal(t, "chore: add nested file", entries[0].Message)
+}
+
+func TestInitRepositoryWithBranches(t *testing.T) {
+	gittest.InitRepository(t, gittest.WithBranches("feature/gadgets", "fix/batmobile"))
+
+	assert.ElementsMatch(t, []string{"feature/gadgets", "fix/batmobile", gittest.DefaultBranch}, gittest.Branches(t))
+	assert.Equal(t, gittest.DefaultBranch, gittest.ShowBranch(t))
+}
+
+func TestInitRepositoryWithTags(t *testing.T) {
+	gittest.InitRepository(t, gittest.WithTags("0.1.0", "v1"))
+
+	assert.ElementsMatch(t, []string{"0.1.0", "v1"}, gittest.Tags(t))
+	assert.ElementsMatch(t, []string{"0.1.0", "v1"}, gittest.RemoteTags(t))
 }
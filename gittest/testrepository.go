@@ -0,0 +1,791 @@
+package gittest
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"mvdan.cc/sh/v3/interp"
+	"mvdan.cc/sh/v3/syntax"
+)
+
+// TestRepository is a handle to a test repository that has been initialized
+// without changing the current working directory of the test process. All
+// commands are executed from within the repository directory. As no global
+// state is mutated, a [TestRepository] is safe to use within tests that are
+// run in parallel. Every package level helper has an equivalent method
+type TestRepository struct {
+	// Dir contains the path to the repository (working directory). An
+	// empty path targets the current working directory of the test process
+	Dir string
+
+	t *testing.T
+}
+
+// InitRepositoryT will attempt to initialize a test repository in the same
+// way as [InitRepository], without changing the current working directory.
+// The same options are supported. A handle to the repository is returned,
+// exposing methods that mirror the existing helper functions. Repository
+// creation consists of two phases. First, a bare repository is initialized,
+// before being cloned locally. Without customization (options), the test
+// repository will consist of a README.md and a single commit:
+//
+//	> git log --oneline
+//	<HASH> initialized repository
+//
+//	> git ls-files
+//	README.md
+func InitRepositoryT(t *testing.T, opts ...RepositoryOption) *TestRepository {
+	t.Helper()
+
+	// Process any provided options to ensure repository is initialized as required
+	options := &repositoryOptions{
+		DefaultBranch: DefaultBranch,
+		RemoteName:    DefaultOrigin,
+	}
+	for _, opt := range opts {
+		opt(options)
+	}
+	branch := options.DefaultBranch
+
+	// Generate two repositories within a temporary directory. The first is
+	// initialized as a bare repository and becomes our filesystem based
+	// remote. The second is our working repository, which is a clone of the
+	// former
+	tmp := &TestRepository{Dir: filepath.ToSlash(t.TempDir()), t: t}
+
+	tmp.MustExec(fmt.Sprintf("git init --bare --initial-branch %s %s", branch, BareRepositoryName))
+	bare := &TestRepository{Dir: tmp.path(BareRepositoryName), t: t}
+	bare.setConfig("receive.advertisePushOptions", "true")
+	repo := tmp.cloneRemoteAndInit(ClonedRepositoryName, options)
+
+	if log := parseLog(options.Log, branch); len(log) > 0 {
+		repo.importLog(log, options)
+	}
+
+	if options.CloneDepth > 0 {
+		// Remove the existing local clone and clone again specifying the depth
+		require.NoError(t, os.RemoveAll(repo.Dir))
+		repo = tmp.cloneRemoteAndInit(ClonedRepositoryName, options, fmt.Sprintf("--depth %d", options.CloneDepth))
+	}
+
+	// To ensure a successful delta is created, an additional clone is made of the
+	// bare (remote) repository. The remote log is then imported, ensuring the
+	// local clone is out of sync
+	if log := parseLog(options.RemoteLog, branch); len(log) > 0 {
+		tmp.cloneRemoteAndInit("remote-import", options).importLog(log, options)
+	}
+
+	if len(options.Branches) > 0 || len(options.Tags) > 0 {
+		initial := repo.MustExec("git rev-list --max-parents=0 HEAD")
+		for _, branch := range options.Branches {
+			repo.MustExec(fmt.Sprintf("git branch '%s' %s", branch, initial))
+		}
+		repo.importTagsAtRef(options.Tags, initial)
+	}
+
+	if options.GPGSigningKey != "" {
+		repo.setConfig("user.signingkey", options.GPGSigningKey)
+		repo.setConfig("commit.gpgsign", "true")
+		repo.setConfig("tag.gpgsign", "true")
+	}
+
+	for _, commit := range options.Commits {
+		repo.Exec(fmt.Sprintf(`git commit --allow-empty -m "%s"`, commit))
+	}
+
+	if len(options.Files) > 0 {
+		for _, f := range options.Files {
+			content := FileContent
+			if fc, exists := options.FileContent[f.Path]; exists {
+				content = fc
+			}
+
+			repo.TempFile(f.Path, content)
+			if f.Staged {
+				repo.StageFile(f.Path)
+			}
+		}
+		if options.CommitFiles {
+			repo.Commit("include test files")
+		}
+	}
+
+	return repo
+}
+
+// resolves the provided path against the repository directory
+func (r *TestRepository) path(path string) string {
+	if r.Dir == "" || filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.ToSlash(filepath.Join(r.Dir, path))
+}
+
+func (r *TestRepository) cloneRemoteAndInit(cloneName string, opts *repositoryOptions, options ...string) *TestRepository {
+	r.t.Helper()
+	r.MustExec(fmt.Sprintf("git clone --origin %s %s file://%s %s",
+		opts.RemoteName, strings.Join(options, " "), r.path(BareRepositoryName), cloneName))
+	clone := &TestRepository{Dir: r.path(cloneName), t: r.t}
+
+	// Ensure author details are set
+	clone.setConfig("user.name", DefaultAuthorName)
+	clone.setConfig("user.email", DefaultAuthorEmail)
+
+	// Check if there any any commits, if not, initialize with readme and push back first commit
+	if out := clone.MustExec("git rev-list -n1 --all"); out == "" {
+		// Ensure the first commit is made against the expected default branch
+		clone.MustExec("git symbolic-ref HEAD refs/heads/" + opts.DefaultBranch)
+		clone.TempFile("README.md", ReadmeContent)
+		clone.StageFile("README.md")
+
+		clone.MustExec(fmt.Sprintf(`git commit -m "%s"`, InitialCommit))
+		clone.MustExec(fmt.Sprintf(gitPushTemplate, opts.RemoteName, opts.DefaultBranch))
+	}
+
+	clone.MustExec(fmt.Sprintf("git remote set-head %s --auto", opts.RemoteName))
+	return clone
+}
+
+func (r *TestRepository) importLog(log []LogEntry, opts *repositoryOptions) {
+	// It is important to reverse the list as we want to write the log back
+	// to the repository in reverse chronological order
+	firstEntry := len(log) - 1
+	trunkIndex := 0
+
+	// If the latest commit contains both the HEAD pointer and trunk reference,
+	// just import without altering the trunk index. This condition is satisfied
+	// by a log line such as:
+	// (HEAD -> another-branch, main, origin/main) this is a commit
+	if log[0].IsTrunk && log[0].HeadPointerRef != "" {
+		goto process
+	}
+
+	// Shift the starting index of the trunk in relation to the head reference
+	for j := trunkIndex + 1; j <= firstEntry; j++ {
+		if log[j].IsTrunk {
+			trunkIndex = j
+			break
+		}
+	}
+
+process:
+	entry := firstEntry
+	for entry >= trunkIndex {
+		r.importLogEntry(log[entry], opts)
+		entry--
+	}
+
+	if log[0].HeadPointerRef != "" {
+		// Since the HEAD pointer reference points at branch other than the default,
+		// checkout out the branch and continue import. The checkout must come before
+		// the import, since we import in reverse chronological order
+		r.MustExec(fmt.Sprintf("git checkout -b %s", log[0].HeadPointerRef))
+		for entry >= 0 {
+			r.importLogEntry(log[entry], opts)
+			entry--
+		}
+	}
+}
+
+func (r *TestRepository) importLogEntry(entry LogEntry, opts *repositoryOptions) {
+	// HACK:
+	// Flip the executable bit allowing the commit to be associated to the file
+	// without altering its contents
+	r.flipExecutableBit("README.md")
+	r.StageFile("README.md")
+	commitCmd := fmt.Sprintf(`git commit -m "%s"`, entry.Message)
+	r.MustExec(commitCmd)
+
+	// Grab the commit hash and use it when creating branches and tags
+	hash := r.MustExec("git rev-parse HEAD")
+
+	r.importBranchesAtRef(entry.Branches, hash, opts)
+	r.importTagsAtRef(entry.Tags, hash)
+}
+
+func (r *TestRepository) importBranchesAtRef(branches []string, ref string, opts *repositoryOptions) {
+	if len(branches) == 0 {
+		return
+	}
+	trunk := opts.DefaultBranch
+	remotePrefix := opts.RemoteName + "/"
+
+	// Track local and remote branches separately
+	local := map[string]struct{}{}
+	remote := map[string]struct{}{}
+
+	for _, branch := range branches {
+		// Filter out any branches that already exist, or are automatically updated
+		if branch == trunk ||
+			branch == remotePrefix+"HEAD" ||
+			strings.HasPrefix(branch, "HEAD") {
+			continue
+		}
+
+		if strings.HasPrefix(branch, remotePrefix) {
+			remote[branch] = struct{}{}
+		} else {
+			local[branch] = struct{}{}
+		}
+	}
+
+	// Detect and push to the default remote branch if needed
+	remoteTrunk := remotePrefix + trunk
+	if _, pushDefault := remote[remoteTrunk]; pushDefault {
+		r.MustExec(fmt.Sprintf(gitPushTemplate, opts.RemoteName, trunk))
+		delete(remote, remoteTrunk)
+	}
+
+	for branch := range remote {
+		cleanedBranch := strings.TrimPrefix(branch, remotePrefix)
+
+		// Check if the branch already exists, before creating it
+		if out := r.MustExec(fmt.Sprintf("git branch --list %s", cleanedBranch)); out == "" {
+			r.MustExec(fmt.Sprintf("git branch %s %s", cleanedBranch, ref))
+		}
+		r.MustExec(fmt.Sprintf(gitPushTemplate, opts.RemoteName, cleanedBranch))
+
+		if _, exists := local[cleanedBranch]; exists {
+			delete(local, cleanedBranch)
+		} else {
+			// Do not attempt to delete the branch locally if checked out
+			if current := r.MustExec("git branch --show-current --no-color"); current != cleanedBranch {
+				r.MustExec(fmt.Sprintf("git branch -d %s", cleanedBranch))
+			}
+		}
+	}
+
+	for branch := range local {
+		r.MustExec(fmt.Sprintf("git branch %s %s", branch, ref))
+	}
+}
+
+func (r *TestRepository) importTagsAtRef(tags []string, ref string) {
+	if len(tags) == 0 {
+		return
+	}
+
+	for _, tag := range tags {
+		tagCmd := fmt.Sprintf("git tag %s %s", tag, ref)
+		r.MustExec(tagCmd)
+	}
+
+	r.MustExec("git push --tags")
+}
+
+func (r *TestRepository) flipExecutableBit(path string) {
+	fi, err := os.Stat(r.path(path))
+	require.NoError(r.t, err, "README.md should exist")
+
+	perms := fi.Mode()
+	if perms&0o100 != 0 {
+		require.NoError(r.t, os.Chmod(r.path(path), perms&^0o100), "failed to turn on executable bit")
+	} else {
+		require.NoError(r.t, os.Chmod(r.path(path), perms|0o100), "failed to turn off executable bit")
+	}
+}
+
+func (r *TestRepository) setConfig(key, value string) {
+	configCmd := fmt.Sprintf(`git config %s "%s"`, key, value)
+	_, err := r.Exec(configCmd)
+	require.NoError(r.t, err)
+}
+
+// TempFile generates a temporary file with the given content at the
+// provided location, relative to the root of the repository. See [TempFile]
+func (r *TestRepository) TempFile(path, content string) {
+	r.t.Helper()
+	fpath := r.path(path)
+
+	require.NoError(r.t, os.MkdirAll(filepath.Dir(fpath), 0o750))
+	require.NoError(r.t, os.WriteFile(fpath, []byte(content), 0o640))
+}
+
+// Exec will execute any given git command and return the raw output and
+// error from the underlying git client. See [Exec]
+func (r *TestRepository) Exec(cmd string) (string, error) {
+	r.t.Helper()
+	return r.ExecContext(context.Background(), cmd)
+}
+
+// ExecContext will execute any given git command, bound by the provided
+// context, and return the raw output and error from the underlying git
+// client. See [ExecContext]
+func (r *TestRepository) ExecContext(ctx context.Context, cmd string) (string, error) {
+	r.t.Helper()
+	p, _ := syntax.NewParser().Parse(strings.NewReader(cmd), "")
+
+	var buf bytes.Buffer
+	opts := []interp.RunnerOption{
+		interp.StdIO(os.Stdin, &buf, &buf),
+	}
+
+	if r.Dir != "" {
+		opts = append(opts, interp.Dir(r.Dir))
+	}
+
+	runner, _ := interp.New(opts...)
+	err := runner.Run(ctx, p)
+	out := strings.TrimSuffix(buf.String(), "\n")
+	if err != nil {
+		return out, fmt.Errorf("%w: %s", err, out)
+	}
+
+	return out, nil
+}
+
+// ExecWithExitCode will execute any given git command and return the raw
+// output, exit code and error from the underlying git client. See
+// [ExecWithExitCode]
+func (r *TestRepository) ExecWithExitCode(cmd string) (string, int, error) {
+	r.t.Helper()
+
+	out, err := r.Exec(cmd)
+	if err == nil {
+		return out, 0, nil
+	}
+
+	if status, ok := interp.IsExitStatus(err); ok {
+		return out, int(status), err
+	}
+
+	return out, -1, err
+}
+
+// MustExec will execute any given git command, requiring no failure. See
+// [MustExec]
+func (r *TestRepository) MustExec(cmd string) string {
+	r.t.Helper()
+
+	out, err := r.Exec(cmd)
+	require.NoError(r.t, err)
+
+	return out
+}
+
+// ConfigSet will set any number of local git config items for the
+// repository. See [ConfigSet]
+func (r *TestRepository) ConfigSet(pairs ...string) {
+	r.t.Helper()
+
+	require.Equal(r.t, len(pairs)%2, 0, "mismatch in number of config pairs")
+	for i := 0; i < len(pairs); i += 2 {
+		r.MustExec(fmt.Sprintf("git config --add %s '%s'", pairs[i], pairs[i+1]))
+	}
+}
+
+// Tags returns a list of all local tags associated with the repository.
+// See [Tags]
+func (r *TestRepository) Tags() []string {
+	r.t.Helper()
+	tags := r.MustExec("git for-each-ref refs/tags --format='%(refname:short)'")
+
+	if tags == "" {
+		return nil
+	}
+
+	return strings.Split(tags, "\n")
+}
+
+// RemoteTags returns a list of all tags that have been pushed to the
+// remote origin of the repository. See [RemoteTags]
+func (r *TestRepository) RemoteTags() []string {
+	r.t.Helper()
+	tagRefs := r.MustExec("git ls-remote --tags")
+
+	tags := make([]string, 0)
+	for _, ref := range strings.Split(tagRefs, "\n") {
+		if _, tag, found := strings.Cut(ref, "refs/tags/"); found {
+			tags = append(tags, tag)
+		}
+	}
+
+	return tags
+}
+
+// WriteFile the given content to a file, relative to the root of the
+// repository. See [WriteFile]
+func (r *TestRepository) WriteFile(path, content string, perm fs.FileMode) {
+	r.t.Helper()
+	require.NoError(r.t, os.WriteFile(r.path(path), []byte(content), perm))
+}
+
+// StageFile will attempt to use the provided path to stage a file that has
+// been modified. See [StageFile]
+func (r *TestRepository) StageFile(path string) {
+	r.t.Helper()
+	r.MustExec(fmt.Sprintf("git add '%s'", path))
+}
+
+// StageAll will stage all changes to new and existing files, respecting
+// the contents of the .gitignore file. See [StageAll]
+func (r *TestRepository) StageAll() {
+	r.t.Helper()
+	r.MustExec("git add -A")
+}
+
+// StagedFile generates a temporary file with the given content and ensures
+// it is staged. See [StagedFile]
+func (r *TestRepository) StagedFile(path, content string) {
+	r.t.Helper()
+	r.TempFile(path, content)
+	r.StageFile(path)
+}
+
+// Move or rename a file within the repository. See [Move]
+func (r *TestRepository) Move(path, to string) {
+	r.t.Helper()
+	require.NoError(r.t, os.MkdirAll(filepath.Dir(r.path(to)), 0o750))
+
+	r.MustExec(fmt.Sprintf("git mv --force '%s' '%s'", path, to))
+}
+
+// Commit a snapshot of all changes within the repository without pushing
+// it to the remote. See [Commit]
+func (r *TestRepository) Commit(message string) {
+	r.t.Helper()
+	r.MustExec(fmt.Sprintf("git commit -m '%s'", message))
+}
+
+// CommitWithAuthor a snapshot of all changes within the repository without
+// pushing it to the remote. See [CommitWithAuthor]
+func (r *TestRepository) CommitWithAuthor(name, email, message string) {
+	r.t.Helper()
+	r.MustExec(fmt.Sprintf("git commit --author='%s <%s>' -m '%s'", name, email, message))
+}
+
+// CommitEmpty allows a snapshot of the repository to be created without
+// any changes. See [CommitEmpty]
+func (r *TestRepository) CommitEmpty(message string) {
+	r.t.Helper()
+	r.MustExec(fmt.Sprintf("git commit --allow-empty -m '%s'", message))
+}
+
+// CommitEmptyWithAuthor allows a snapshot of the repository to be created
+// without any changes. See [CommitEmptyWithAuthor]
+func (r *TestRepository) CommitEmptyWithAuthor(name, email, message string) {
+	r.t.Helper()
+	r.MustExec(fmt.Sprintf("git commit --allow-empty --author='%s <%s>' -m '%s'", name, email, message))
+}
+
+// LastCommit returns the last commit from the git log of the repository.
+// See [LastCommit]
+func (r *TestRepository) LastCommit() CommitDetails {
+	r.t.Helper()
+	return parseCommitDetails(r.MustExec("git log -n1"))
+}
+
+// PorcelainStatus returns a snapshot of the current status of the
+// repository in an easy to parse format. See [PorcelainStatus]
+func (r *TestRepository) PorcelainStatus() []string {
+	r.t.Helper()
+
+	status := r.MustExec("git status --porcelain")
+	if status == "" {
+		return nil
+	}
+
+	return strings.Split(status, "\n")
+}
+
+// Log returns the log history of the repository as it currently exists on
+// the default branch. See [Log]
+func (r *TestRepository) Log() []LogEntry {
+	r.t.Helper()
+	trunk := r.defaultBranch()
+	log := r.MustExec(fmt.Sprintf("git log --pretty='format:> %%H %%d %%s%%+b%%-N' %s", trunk))
+	return parseLog(log, trunk)
+}
+
+// LogFor returns the log history of the repository at the given paths. See
+// [LogFor]
+func (r *TestRepository) LogFor(paths ...string) []LogEntry {
+	r.t.Helper()
+	var quotedPaths []string
+	for _, path := range paths {
+		quotedPaths = append(quotedPaths, fmt.Sprintf("'%s'", path))
+	}
+
+	log := r.MustExec(fmt.Sprintf("git log --pretty='format:> %%H %%d %%s%%+b%%-N' -- %s", strings.Join(quotedPaths, " ")))
+	return ParseLog(log)
+}
+
+// LogBetween returns the log history of the repository between two
+// references. See [LogBetween]
+func (r *TestRepository) LogBetween(from, to string) []LogEntry {
+	r.t.Helper()
+	log := r.MustExec(fmt.Sprintf("git log --pretty='format:> %%H %%d %%s%%+b%%-N' %s..%s", from, to))
+	return ParseLog(log)
+}
+
+// RemoteLog returns the log history of the repository as it currently
+// exists on the remote. See [RemoteLog]
+func (r *TestRepository) RemoteLog() []LogEntry {
+	r.t.Helper()
+	trunk := r.defaultBranch()
+	log := r.MustExec(fmt.Sprintf("git log --pretty='format:> %%H %%d %%s%%+b%%-N' %s/%s", r.remoteName(), trunk))
+	return parseLog(log, trunk)
+}
+
+// resolves the default branch of the repository from the remote HEAD,
+// falling back to [DefaultBranch] if it cannot be determined
+func (r *TestRepository) defaultBranch() string {
+	r.t.Helper()
+	_, trunk := r.trackedRemote()
+	return trunk
+}
+
+// resolves the name of the remote configured for the repository,
+// falling back to [DefaultOrigin] if none exist
+func (r *TestRepository) remoteName() string {
+	r.t.Helper()
+	remote, _ := r.trackedRemote()
+	return remote
+}
+
+// resolves the remote tracked by the default branch of the repository,
+// along with the name of that branch. The tracked remote is recorded
+// during initialization by pushing the default branch upstream. If no
+// tracked remote is found, [DefaultOrigin] is preferred over any other
+// remote
+func (r *TestRepository) trackedRemote() (string, string) {
+	r.t.Helper()
+	out, err := r.Exec("git remote")
+	if err != nil || out == "" {
+		return DefaultOrigin, DefaultBranch
+	}
+
+	remotes := splitLines(out)
+	for i, remote := range remotes {
+		if remote == DefaultOrigin {
+			remotes[0], remotes[i] = remotes[i], remotes[0]
+			break
+		}
+	}
+
+	for _, remote := range remotes {
+		trunk := r.remoteHead(remote)
+		if tracked, _ := r.Exec(fmt.Sprintf("git config branch.%s.remote", trunk)); tracked == remote {
+			return remote, trunk
+		}
+	}
+
+	return remotes[0], r.remoteHead(remotes[0])
+}
+
+// resolves the branch referenced by the HEAD of the given remote, falling
+// back to [DefaultBranch] if it cannot be determined
+func (r *TestRepository) remoteHead(remote string) string {
+	r.t.Helper()
+	ref, err := r.Exec(fmt.Sprintf("git symbolic-ref --short refs/remotes/%s/HEAD", remote))
+	if err != nil || ref == "" {
+		return DefaultBranch
+	}
+	return strings.TrimPrefix(ref, remote+"/")
+}
+
+// Tag creates a lightweight tag that is only tracked locally and will not
+// have been pushed back to the remote repository. See [Tag]
+func (r *TestRepository) Tag(tag string) {
+	r.t.Helper()
+	r.MustExec(fmt.Sprintf("git tag '%s'", tag))
+}
+
+// TagAnnotated creates an annotated tag that is only tracked locally and
+// will not have been pushed back to the remote repository. See
+// [TagAnnotated]
+func (r *TestRepository) TagAnnotated(tag, msg string) {
+	r.t.Helper()
+	r.MustExec(fmt.Sprintf("git tag -a '%s' -m '%s'", tag, msg))
+}
+
+// TagRemote creates lightweight tag that is only tracked at the remote.
+// See [TagRemote]
+func (r *TestRepository) TagRemote(tag string) {
+	r.t.Helper()
+	r.Tag(tag)
+	r.MustExec(fmt.Sprintf("git push %s '%s'", r.remoteName(), tag))
+	r.MustExec(fmt.Sprintf("git tag -d '%s'", tag))
+}
+
+// Show will display information about a specific git object. See [Show]
+func (r *TestRepository) Show(object string) string {
+	r.t.Helper()
+	return r.MustExec(fmt.Sprintf("git show '%s'", object))
+}
+
+// Checkout will update the state of the repository by updating files in
+// the tree to a specific point in time. See [Checkout]
+func (r *TestRepository) Checkout(object string) string {
+	r.t.Helper()
+	return r.MustExec(fmt.Sprintf("git checkout '%s'", object))
+}
+
+// Merge will merge the provided reference into the current branch of the
+// repository. See [Merge]
+func (r *TestRepository) Merge(ref string) {
+	r.t.Helper()
+	r.MustExec(fmt.Sprintf("git merge --no-ff --no-edit '%s'", ref))
+}
+
+// Stash will record the current state of the repository and index within a
+// new stash entry, reverting any local changes. See [Stash]
+func (r *TestRepository) Stash() {
+	r.t.Helper()
+	r.MustExec("git stash push")
+}
+
+// StashWith will record the current state of the repository and index
+// within a new stash entry, associated with the provided message. See
+// [StashWith]
+func (r *TestRepository) StashWith(message string) {
+	r.t.Helper()
+	r.MustExec(fmt.Sprintf("git stash push -m '%s'", message))
+}
+
+// Remote will retrieve the URL of the remote (typically origin) configured
+// for the repository. See [Remote]
+func (r *TestRepository) Remote() string {
+	r.t.Helper()
+	remote := r.MustExec("git ls-remote --get-url")
+
+	// Ensure path is escaped correctly when testing across different OS
+	return filepath.ToSlash(remote)
+}
+
+// ShowBranch will retrieve the name of the current branch. See
+// [ShowBranch]
+func (r *TestRepository) ShowBranch() string {
+	r.t.Helper()
+	return r.MustExec("git branch --show-current")
+}
+
+// Branches returns a list of all local branches associated with the
+// repository. See [Branches]
+func (r *TestRepository) Branches() []string {
+	r.t.Helper()
+	branches := r.MustExec("git branch --list --format='%(refname:short)'")
+
+	if branches == "" {
+		return nil
+	}
+
+	return strings.Split(branches, "\n")
+}
+
+// CreateBranch will create a new local branch from the current HEAD of the
+// repository, without checking it out. See [CreateBranch]
+func (r *TestRepository) CreateBranch(name string) {
+	r.t.Helper()
+	r.MustExec(fmt.Sprintf("git branch '%s'", name))
+}
+
+// DeleteBranch will delete a local branch from the repository. See
+// [DeleteBranch]
+func (r *TestRepository) DeleteBranch(name string) {
+	r.t.Helper()
+	r.MustExec(fmt.Sprintf("git branch -d '%s'", name))
+}
+
+// RemoteBranches returns a list of all branches that have been pushed to
+// the remote origin of the repository. See [RemoteBranches]
+func (r *TestRepository) RemoteBranches() []string {
+	r.t.Helper()
+	branches := r.MustExec("git branch --list --remotes --format='%(refname:short)'")
+
+	if branches == "" {
+		return nil
+	}
+
+	cleanedBranches := make([]string, 0)
+	for _, branch := range strings.Split(branches, "\n") {
+		sep := strings.Index(branch, "/")
+		cleanedBranches = append(cleanedBranches, branch[sep+1:])
+	}
+	return cleanedBranches
+}
+
+// WorkingDirectory returns the working directory (root) of the repository.
+// See [WorkingDirectory]
+func (r *TestRepository) WorkingDirectory() string {
+	r.t.Helper()
+	return filepath.ToSlash(r.MustExec("git rev-parse --show-toplevel"))
+}
+
+// ObjectRef scans the tree of the repository for a an object identified by
+// the provided file path. See [ObjectRef]
+func (r *TestRepository) ObjectRef(path string) string {
+	r.t.Helper()
+	require.NotEmpty(r.t, path)
+
+	fpath := filepath.ToSlash(path)
+	if fpath[0] == '/' {
+		fpath = fpath[1:]
+	}
+	require.NotEmpty(r.t, fpath, "path must contain more than a leading slash")
+
+	objectID := ""
+
+	// Initial parse of the git tree will always start from the HEAD
+	ref := "HEAD"
+	for _, fpart := range strings.Split(fpath, "/") {
+		tree := r.MustExec("git ls-tree " + ref)
+
+		scanner := bufio.NewScanner(strings.NewReader(tree))
+		scanner.Split(bufio.ScanLines)
+
+		for scanner.Scan() {
+			// Expected format of each line, the object ID is either a SHA-1
+			// or SHA-256 hash, dependent on the object format:
+			// 100644 blob 672108528b5bdf1b1919f9f215149baae48d00e2\tREADME.md
+			meta, name, found := strings.Cut(scanner.Text(), "\t")
+			if !found || name != fpart {
+				continue
+			}
+
+			if fields := strings.Fields(meta); len(fields) == 3 {
+				ref = fields[2]
+				objectID = ref
+				break
+			}
+		}
+	}
+
+	return objectID
+}
+
+// Blob retrieves the string representation of a blob within the git tree.
+// See [Blob]
+func (r *TestRepository) Blob(path string) string {
+	r.t.Helper()
+
+	ref := r.ObjectRef(path)
+	if ref == "" {
+		return ""
+	}
+
+	return r.MustExec("git show -s " + ref)
+}
+
+// BlobAt retrieves the string representation of a file within the git tree
+// at the given reference. See [BlobAt]
+func (r *TestRepository) BlobAt(ref, path string) string {
+	r.t.Helper()
+	return r.MustExec(fmt.Sprintf("git show '%s:%s'", ref, filepath.ToSlash(path)))
+}
+
+func splitLines(out string) []string {
+	if out == "" {
+		return nil
+	}
+
+	return strings.Split(out, "\n")
+}
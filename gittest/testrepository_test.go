@@ -0,0 +1,67 @@
+package gittest_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/purpleclay/gitz/gittest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInitRepositoryT(t *testing.T) {
+	cwd, err := os.Getwd()
+	require.NoError(t, err)
+
+	repo := gittest.InitRepositoryT(t)
+
+	current, err := os.Getwd()
+	require.NoError(t, err)
+	assert.Equal(t, cwd, current)
+
+	assert.Equal(t, gittest.DefaultBranch, repo.ShowBranch())
+	assert.Equal(t, gittest.InitialCommit, repo.LastCommit().Message)
+	assert.Empty(t, repo.PorcelainStatus())
+}
+
+func TestInitRepositoryTParallel(t *testing.T) {
+	for _, hero := range []string{"batman", "robin"} {
+		t.Run(hero, func(t *testing.T) {
+			t.Parallel()
+			repo := gittest.InitRepositoryT(t)
+
+			repo.TempFile("gadget.txt", hero)
+			repo.StageFile("gadget.txt")
+			repo.Commit("feat: gadget for " + hero)
+			repo.Tag("0.1.0")
+
+			log := repo.Log()
+			require.Len(t, log, 2)
+			assert.Equal(t, "feat: gadget for "+hero, log[0].Message)
+			assert.Equal(t, []string{"0.1.0"}, repo.Tags())
+		})
+	}
+}
+
+func TestInitRepositoryTWithOptions(t *testing.T) {
+	log := `(tag: 0.1.0, trunk, upstream/trunk) feat: gadgets for the batmobile`
+	repo := gittest.InitRepositoryT(t,
+		gittest.WithDefaultBranchName("trunk"),
+		gittest.WithRemoteName("upstream"),
+		gittest.WithLog(log),
+		gittest.WithStagedFiles("batarang.txt"))
+
+	assert.Equal(t, "trunk", repo.ShowBranch())
+	assert.Equal(t, []string{"A  batarang.txt"}, repo.PorcelainStatus())
+
+	repo.TagRemote("0.2.0")
+	assert.ElementsMatch(t, []string{"0.1.0", "0.2.0"}, repo.RemoteTags())
+
+	remoteLog := repo.RemoteLog()
+	require.Len(t, remoteLog, 2)
+	assert.Equal(t, "feat: gadgets for the batmobile", remoteLog[0].Message)
+	assert.Contains(t, remoteLog[0].Branches, "upstream/trunk")
+
+	repo.CreateBranch("feature")
+	assert.ElementsMatch(t, []string{"feature", "trunk"}, repo.Branches())
+}
@@ -0,0 +1,137 @@
+package git
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// GrepOption provides a way for setting specific options during a grep
+// operation. Each supported option can customize the way tracked content
+// is searched within the current repository (working directory)
+type GrepOption func(*grepOptions)
+
+type grepOptions struct {
+	FixedStrings bool
+	IgnoreCase   bool
+	PathSpecs    []string
+	Ref          string
+}
+
+// WithGrepFixedStrings treats the pattern as a fixed string, rather than
+// a regular expression
+func WithGrepFixedStrings() GrepOption {
+	return func(opts *grepOptions) {
+		opts.FixedStrings = true
+	}
+}
+
+// WithGrepIgnoreCase ignores any case differences between the pattern
+// and the searched content
+func WithGrepIgnoreCase() GrepOption {
+	return func(opts *grepOptions) {
+		opts.IgnoreCase = true
+	}
+}
+
+// WithGrepPathSpecs permits a series of [PathSpecs] (or globs) to be
+// defined that will limit the search to any matching files. Paths to
+// files and folders are relative to the root of the repository. All
+// leading and trailing whitespace will be trimmed from the file paths,
+// allowing empty paths to be ignored
+//
+// [PathSpecs]: https://git-scm.com/docs/gitglossary#Documentation/gitglossary.txt-aiddefpathspecapathspec
+func WithGrepPathSpecs(specs ...string) GrepOption {
+	return func(opts *grepOptions) {
+		opts.PathSpecs = trim(specs...)
+	}
+}
+
+// WithGrepRef searches the tree of files associated with a reference,
+// such as a commit, branch or tag, rather than the working tree. An
+// empty string will be ignored
+func WithGrepRef(ref string) GrepOption {
+	return func(opts *grepOptions) {
+		opts.Ref = strings.TrimSpace(ref)
+	}
+}
+
+// GrepMatch represents a single line within a tracked file that
+// matches a search pattern
+type GrepMatch struct {
+	// Path of the file relative to the root of the current repository
+	Path string
+
+	// LineNo contains the line number of the match, starting at one
+	LineNo int
+
+	// Line contains the entire content of the matching line
+	Line string
+}
+
+// Grep searches all tracked files within the current repository (working
+// directory) for lines matching the provided pattern (regular expression).
+// By default, the working tree is searched. No error is returned if there
+// are no matches
+func (c *Client) Grep(pattern string, opts ...GrepOption) ([]GrepMatch, error) {
+	options := &grepOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	var buf strings.Builder
+	buf.WriteString("git grep --full-name --line-number --null --no-color")
+
+	if options.IgnoreCase {
+		buf.WriteString(" --ignore-case")
+	}
+
+	if options.FixedStrings {
+		buf.WriteString(" --fixed-strings")
+	}
+
+	buf.WriteString(fmt.Sprintf(" -e '%s'", escapeQuotes(pattern)))
+
+	if options.Ref != "" {
+		buf.WriteString(" ")
+		buf.WriteString(options.Ref)
+	}
+
+	if len(options.PathSpecs) > 0 {
+		buf.WriteString(" --")
+		for _, spec := range options.PathSpecs {
+			buf.WriteString(fmt.Sprintf(" '%s'", spec))
+		}
+	}
+
+	out, err := c.Exec(buf.String())
+	if err != nil {
+		// Git exits with an exit code of 1 if there are no matches
+		if isExitCode(err, 1) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return parseGrep(out, options.Ref), nil
+}
+
+func parseGrep(out, ref string) []GrepMatch {
+	var matches []GrepMatch
+	for _, line := range strings.Split(out, "\n") {
+		// <path>\0<line number>\0<line>
+		fields := strings.SplitN(line, "\x00", 3)
+		if len(fields) != 3 {
+			continue
+		}
+
+		lineNo, _ := strconv.Atoi(fields[1])
+		matches = append(matches, GrepMatch{
+			Path:   strings.TrimPrefix(fields[0], ref+":"),
+			LineNo: lineNo,
+			Line:   fields[2],
+		})
+	}
+
+	return matches
+}
@@ -0,0 +1,98 @@
+package git_test
+
+import (
+	"testing"
+
+	git "github.com/purpleclay/gitz"
+	"github.com/purpleclay/gitz/gittest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGrep(t *testing.T) {
+	gittest.InitRepository(t)
+	gittest.StagedFile(t, "internal/search.go", "package internal\n\n// TODO: implement search\nfunc Search() {}")
+	gittest.Commit(t, "feat: add search")
+
+	client, _ := git.NewClient()
+	matches, err := client.Grep("TODO")
+	require.NoError(t, err)
+
+	require.Len(t, matches, 1)
+	assert.Equal(t, git.GrepMatch{
+		Path:   "internal/search.go",
+		LineNo: 3,
+		Line:   "// TODO: implement search",
+	}, matches[0])
+}
+
+func TestGrepWithGrepIgnoreCase(t *testing.T) {
+	gittest.InitRepository(t)
+	gittest.StagedFile(t, "search.txt", "Token\ntoken\nTOKEN\nnothing")
+	gittest.Commit(t, "feat: add tokens")
+
+	client, _ := git.NewClient()
+	matches, err := client.Grep("token")
+	require.NoError(t, err)
+	require.Len(t, matches, 1)
+
+	matches, err = client.Grep("token", git.WithGrepIgnoreCase())
+	require.NoError(t, err)
+	assert.Len(t, matches, 3)
+}
+
+func TestGrepWithGrepFixedStrings(t *testing.T) {
+	gittest.InitRepository(t)
+	gittest.StagedFile(t, "search.txt", "a.b\naxb")
+	gittest.Commit(t, "feat: add content to search")
+
+	client, _ := git.NewClient()
+	matches, err := client.Grep("a.b", git.WithGrepFixedStrings())
+	require.NoError(t, err)
+
+	require.Len(t, matches, 1)
+	assert.Equal(t, "a.b", matches[0].Line)
+}
+
+func TestGrepWithGrepRef(t *testing.T) {
+	gittest.InitRepository(t)
+	gittest.StagedFile(t, "search.txt", "a token that will be removed")
+	gittest.Commit(t, "feat: add a token")
+	overwriteFile(t, "search.txt", "nothing to see here")
+	gittest.StageFile(t, "search.txt")
+	gittest.Commit(t, "feat: remove the token")
+
+	client, _ := git.NewClient()
+	matches, err := client.Grep("token")
+	require.NoError(t, err)
+	assert.Empty(t, matches)
+
+	matches, err = client.Grep("token", git.WithGrepRef("HEAD~1"))
+	require.NoError(t, err)
+
+	require.Len(t, matches, 1)
+	assert.Equal(t, "search.txt", matches[0].Path)
+}
+
+func TestGrepWithGrepPathSpecs(t *testing.T) {
+	gittest.InitRepository(t)
+	gittest.StagedFile(t, "a.go", "token")
+	gittest.StagedFile(t, "b.txt", "token")
+	gittest.Commit(t, "feat: add tokens")
+
+	client, _ := git.NewClient()
+	matches, err := client.Grep("token", git.WithGrepPathSpecs("*.go"))
+	require.NoError(t, err)
+
+	require.Len(t, matches, 1)
+	assert.Equal(t, "a.go", matches[0].Path)
+}
+
+func TestGrepInvalidRefError(t *testing.T) {
+	gittest.InitRepository(t)
+
+	client, _ := git.NewClient()
+	_, err := client.Grep("token", git.WithGrepRef("does-not-exist"))
+
+	require.ErrorAs(t, err, &git.ErrGitExecCommand{})
+}
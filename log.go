@@ -2,8 +2,12 @@ package git
 
 import (
 	"bufio"
+	"bytes"
+	"context"
 	"fmt"
+	"io"
 	"strings"
+	"time"
 
 	"github.com/purpleclay/gitz/scan"
 )
@@ -22,8 +26,26 @@ type logOptions struct {
 	Matches      []string
 	InverseMatch bool
 	MatchAll     bool
+	Authors      []string
+	Committers   []string
+	Merges       string
+	FirstParent  bool
+	Extended     bool
+	Follow       bool
+	Reverse      bool
+	PrettyFormat string
+	Parser       func(string) []LogEntry
 }
 
+const (
+	// Separators used when retrieving extended fields from the log. Both are
+	// non-printable ASCII characters that will not appear in a commit message
+	logRecordSeparator = "\x1e"
+	logFieldSeparator  = "\x1f"
+
+	logExtendedFormat = "--pretty='format:%x1e%H%x1f%an%x1f%ae%x1f%cn%x1f%ce%x1f%aI%x1f%B%x1f%b'"
+)
+
 // WithRef provides a starting point other than HEAD (most recent commit)
 // when retrieving the log history of the current repository (working
 // directory). Typically a reference can be either a commit hash, branch
@@ -136,6 +158,109 @@ func WithMatchAll() LogOption {
 	}
 }
 
+// WithLogAuthor limits the number of commits that will be output within
+// the log history to any with an author that matches one of the provided
+// patterns (regular expressions). A pattern is matched against both the
+// name and email of the author. All leading and trailing whitespace will
+// be trimmed, allowing empty patterns to be ignored
+func WithLogAuthor(patterns ...string) LogOption {
+	return func(opts *logOptions) {
+		opts.Authors = trim(patterns...)
+	}
+}
+
+// WithLogCommitter limits the number of commits that will be output within
+// the log history to any with a committer that matches one of the provided
+// patterns (regular expressions). A pattern is matched against both the
+// name and email of the committer. All leading and trailing whitespace will
+// be trimmed, allowing empty patterns to be ignored
+func WithLogCommitter(patterns ...string) LogOption {
+	return func(opts *logOptions) {
+		opts.Committers = trim(patterns...)
+	}
+}
+
+// WithMergesOnly limits the number of commits that will be output within
+// the log history to merge commits only. A merge commit has more than
+// one parent. This option is mutually exclusive with [WithNoMerges]
+func WithMergesOnly() LogOption {
+	return func(opts *logOptions) {
+		opts.Merges = "--merges"
+	}
+}
+
+// WithNoMerges excludes all merge commits from the log history. A merge
+// commit has more than one parent. This option is mutually exclusive
+// with [WithMergesOnly]
+func WithNoMerges() LogOption {
+	return func(opts *logOptions) {
+		opts.Merges = "--no-merges"
+	}
+}
+
+// WithFirstParent only follows the first parent of any merge commit when
+// retrieving the log history. Any commits introduced by a merged branch
+// will be excluded, resulting in a log that reflects the history of
+// the current branch
+func WithFirstParent() LogOption {
+	return func(opts *logOptions) {
+		opts.FirstParent = true
+	}
+}
+
+// WithReverse retrieves the log history in reverse order, with the oldest
+// commit first. Git reverses the log after any commits have been limited,
+// so when combined with [WithTake], the most recent commits are taken
+// before being reversed
+func WithReverse() LogOption {
+	return func(opts *logOptions) {
+		opts.Reverse = true
+	}
+}
+
+// WithFollow continues to retrieve the log history of a file beyond any
+// renames. Must be used in combination with [WithPaths], and is only
+// supported by git when a single path is provided
+func WithFollow() LogOption {
+	return func(opts *logOptions) {
+		opts.Follow = true
+	}
+}
+
+// WithExtendedFields populates additional fields within each [LogEntry],
+// such as the author, committer, author date and body of each commit.
+// Extended fields are retrieved using a record separated format, which
+// is reflected within the raw output of the log
+func WithExtendedFields() LogOption {
+	return func(opts *logOptions) {
+		opts.Extended = true
+	}
+}
+
+// WithPrettyFormat replaces the default format used when retrieving the
+// log history with a custom one. Any placeholder supported by the git
+// [pretty format] can be used, for example '%h %an %s'. As the format is
+// unknown, parsing of the log is skipped, resulting in an empty
+// [Log.Commits] slice, unless a parser is provided using the
+// [WithLogParser] option. This option has a higher order of precedence
+// than [WithExtendedFields]
+//
+// [pretty format]: https://git-scm.com/docs/git-log#_pretty_formats
+func WithPrettyFormat(format string) LogOption {
+	return func(opts *logOptions) {
+		opts.PrettyFormat = strings.TrimSpace(format)
+	}
+}
+
+// WithLogParser provides a custom parser for converting the raw output
+// of the log into a structured format. Typically used in combination with
+// the [WithPrettyFormat] option
+func WithLogParser(parser func(raw string) []LogEntry) LogOption {
+	return func(opts *logOptions) {
+		opts.Parser = parser
+	}
+}
+
 // Log represents a snapshot of commit history from a repository
 type Log struct {
 	// Raw contains the raw commit log
@@ -158,6 +283,25 @@ type LogEntry struct {
 
 	// Message contains the message associated with the commit
 	Message string
+
+	// Author contains details of the person who originally wrote the
+	// changes within the commit. Only populated when retrieved using
+	// the [WithExtendedFields] option
+	Author Person
+
+	// AuthorDate contains the date and time the commit was originally
+	// authored. Only populated when retrieved using the [WithExtendedFields]
+	// option
+	AuthorDate time.Time
+
+	// Committer contains details of the person who committed the changes,
+	// which can differ from the author. Only populated when retrieved using
+	// the [WithExtendedFields] option
+	Committer Person
+
+	// Body contains the body of the commit message, excluding its subject.
+	// Only populated when retrieved using the [WithExtendedFields] option
+	Body string
 }
 
 // Log retrieves the commit log of the current repository (working directory)
@@ -168,6 +312,132 @@ type LogEntry struct {
 //
 //	git log --pretty='format:> %H %B%-N' --no-color
 func (c *Client) Log(opts ...LogOption) (*Log, error) {
+	options := newLogOptions(opts...)
+
+	out, err := c.Exec("git log" + options.String())
+	if err != nil {
+		return nil, err
+	}
+
+	log := &Log{Raw: out}
+	// Support the option to skip parsing of the log into a structured format
+	if !options.SkipParse {
+		switch {
+		case options.Parser != nil:
+			log.Commits = options.Parser(out)
+		case options.PrettyFormat != "":
+			// A custom format cannot be parsed without a parser
+		case options.Extended:
+			log.Commits = parseExtendedLog(out)
+		default:
+			log.Commits = parseLog(out)
+		}
+	}
+
+	return log, nil
+}
+
+// CommitsBetween retrieves the parsed log history between two references
+// within the current repository (working directory). Typically a reference
+// can be either a commit hash, branch name or tag. Only commits reachable
+// from the to reference, but not the older from reference, are included.
+// Any provided options are applied before the range, which will take
+// precedence over both [WithRef] and [WithRefRange]. Commits are returned
+// with the most recent first. The range is equivalent to:
+//
+//	git log <from>..<to>
+func (c *Client) CommitsBetween(from, to string, opts ...LogOption) ([]LogEntry, error) {
+	between := func(opts *logOptions) {
+		opts.RefRange = fmt.Sprintf("%s..%s", strings.TrimSpace(from), strings.TrimSpace(to))
+	}
+
+	log, err := c.Log(append(opts, between)...)
+	if err != nil {
+		return nil, err
+	}
+
+	return log.Commits, nil
+}
+
+// LogStream retrieves the commit log of the current repository (working
+// directory) in the same way as [Client.Log], but emits each parsed entry
+// through a channel as soon as it has been read from git. This avoids
+// holding the entire log in memory, making it better suited to large
+// repositories. Both channels are closed once the log has been read. Any
+// error will be sent on the error channel before it is closed. Cancelling
+// the context stops the stream early, terminating the underlying git
+// command and sending the context error. The entry channel must either be
+// drained or the context cancelled to prevent the underlying git command
+// from blocking. The [WithRawOnly], [WithPrettyFormat] and [WithLogParser]
+// options are ignored
+func (c *Client) LogStream(ctx context.Context, opts ...LogOption) (<-chan LogEntry, <-chan error) {
+	options := newLogOptions(opts...)
+	options.PrettyFormat = ""
+
+	entries := make(chan LogEntry)
+	errs := make(chan error, 1)
+
+	pr, pw := io.Pipe()
+	go func() {
+		var errOut bytes.Buffer
+		pw.CloseWithError(c.runContext(ctx, "git log"+options.String(), nil, pw, &errOut, &errOut))
+	}()
+
+	go func() {
+		defer close(errs)
+		defer close(entries)
+
+		err := streamLog(ctx, pr, options, entries)
+
+		// Ensure git is never left blocked writing to the pipe if the
+		// stream has stopped early
+		pr.CloseWithError(err)
+		if err != nil {
+			errs <- err
+		}
+	}()
+
+	return entries, errs
+}
+
+// The largest log entry that can be streamed, ensuring commits with
+// an unusually large message can still be read
+const maxLogEntrySize = 16 * 1024 * 1024
+
+func streamLog(ctx context.Context, r io.Reader, options *logOptions, entries chan<- LogEntry) error {
+	parse := parseLogEntry
+	prefix := byte('>')
+	if options.Extended {
+		parse = parseExtendedLogEntry
+		prefix = logRecordSeparator[0]
+	}
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, bufio.MaxScanTokenSize), maxLogEntrySize)
+	scanner.Split(scan.PrefixedLines(prefix))
+
+	for scanner.Scan() {
+		entry, ok := parse(scanner.Text())
+		if !ok {
+			continue
+		}
+
+		select {
+		case entries <- entry:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	// Cancelling the context terminates git, so report the cancellation
+	// rather than the resulting command failure
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return scanner.Err()
+}
+
+func newLogOptions(opts ...LogOption) *logOptions {
 	options := &logOptions{
 		// Disable both counts by default
 		SkipCount: disabledNumericOption,
@@ -177,61 +447,84 @@ func (c *Client) Log(opts ...LogOption) (*Log, error) {
 		opt(options)
 	}
 
-	// Build command based on the provided options
-	var logCmd strings.Builder
-	logCmd.WriteString("git log ")
+	return options
+}
+
+func (o logOptions) String() string {
+	var buf strings.Builder
+	buf.WriteString(" ")
 
-	if options.SkipCount > 0 {
-		logCmd.WriteString(" ")
-		logCmd.WriteString(fmt.Sprintf("--skip %d", options.SkipCount))
+	if o.SkipCount > 0 {
+		buf.WriteString(" ")
+		buf.WriteString(fmt.Sprintf("--skip %d", o.SkipCount))
 	}
 
-	if options.TakeCount > disabledNumericOption {
-		logCmd.WriteString(" ")
-		logCmd.WriteString(fmt.Sprintf("-n%d", options.TakeCount))
+	if o.TakeCount > disabledNumericOption {
+		buf.WriteString(" ")
+		buf.WriteString(fmt.Sprintf("-n%d", o.TakeCount))
 	}
 
-	if len(options.Matches) > 0 {
-		for _, match := range options.Matches {
-			logCmd.WriteString(" ")
-			logCmd.WriteString(fmt.Sprintf("--grep %s", match))
+	if len(o.Matches) > 0 {
+		for _, match := range o.Matches {
+			buf.WriteString(" ")
+			buf.WriteString(fmt.Sprintf("--grep %s", match))
 		}
 	}
 
-	if options.InverseMatch {
-		logCmd.WriteString(" --invert-grep")
+	if o.InverseMatch {
+		buf.WriteString(" --invert-grep")
 	}
 
-	if options.MatchAll {
-		logCmd.WriteString(" --all-match")
+	if o.MatchAll {
+		buf.WriteString(" --all-match")
 	}
 
-	if options.RefRange != "" {
-		logCmd.WriteString(" ")
-		logCmd.WriteString(options.RefRange)
+	if o.Merges != "" {
+		buf.WriteString(" ")
+		buf.WriteString(o.Merges)
 	}
 
-	logCmd.WriteString(" --pretty='format:> %H %B%-N' --no-color")
+	if o.FirstParent {
+		buf.WriteString(" --first-parent")
+	}
 
-	if len(options.LogPaths) > 0 {
-		logCmd.WriteString(" --")
-		for _, path := range options.LogPaths {
-			logCmd.WriteString(fmt.Sprintf(" '%s'", path))
-		}
+	if o.Follow {
+		buf.WriteString(" --follow")
 	}
 
-	out, err := c.Exec(logCmd.String())
-	if err != nil {
-		return nil, err
+	if o.Reverse {
+		buf.WriteString(" --reverse")
 	}
 
-	log := &Log{Raw: out}
-	// Support the option to skip parsing of the log into a structured format
-	if !options.SkipParse {
-		log.Commits = parseLog(out)
+	for _, author := range o.Authors {
+		buf.WriteString(fmt.Sprintf(" --author='%s'", escapeQuotes(author)))
 	}
 
-	return log, nil
+	for _, committer := range o.Committers {
+		buf.WriteString(fmt.Sprintf(" --committer='%s'", escapeQuotes(committer)))
+	}
+
+	if o.RefRange != "" {
+		buf.WriteString(" ")
+		buf.WriteString(o.RefRange)
+	}
+
+	if o.PrettyFormat != "" {
+		buf.WriteString(fmt.Sprintf(" --pretty='format:%s' --no-color", escapeQuotes(o.PrettyFormat)))
+	} else if o.Extended {
+		buf.WriteString(" " + logExtendedFormat + " --no-color")
+	} else {
+		buf.WriteString(" --pretty='format:> %H %B%-N' --no-color")
+	}
+
+	if len(o.LogPaths) > 0 {
+		buf.WriteString(" --")
+		for _, path := range o.LogPaths {
+			buf.WriteString(fmt.Sprintf(" '%s'", path))
+		}
+	}
+
+	return buf.String()
 }
 
 func parseLog(log string) []LogEntry {
@@ -241,17 +534,57 @@ func parseLog(log string) []LogEntry {
 	scanner.Split(scan.PrefixedLines('>'))
 
 	for scanner.Scan() {
-		// Expected format of log from using the --online format is: <hash><space><message>
-		if hash, msg, found := strings.Cut(scanner.Text(), " "); found {
-			msg = cleanLineEndings(msg)
-
-			entries = append(entries, LogEntry{
-				Hash:       hash,
-				AbbrevHash: hash[:7],
-				Message:    msg,
-			})
+		if entry, ok := parseLogEntry(scanner.Text()); ok {
+			entries = append(entries, entry)
 		}
 	}
 
 	return entries
 }
+
+func parseLogEntry(text string) (LogEntry, bool) {
+	// Expected format of log from using the --online format is: <hash><space><message>
+	hash, msg, found := strings.Cut(text, " ")
+	if !found {
+		return LogEntry{}, false
+	}
+
+	return LogEntry{
+		Hash:       hash,
+		AbbrevHash: hash[:7],
+		Message:    cleanLineEndings(msg),
+	}, true
+}
+
+func parseExtendedLog(log string) []LogEntry {
+	var entries []LogEntry
+
+	scanner := bufio.NewScanner(strings.NewReader(log))
+	scanner.Split(scan.PrefixedLines(logRecordSeparator[0]))
+
+	for scanner.Scan() {
+		if entry, ok := parseExtendedLogEntry(scanner.Text()); ok {
+			entries = append(entries, entry)
+		}
+	}
+
+	return entries
+}
+
+func parseExtendedLogEntry(record string) (LogEntry, bool) {
+	fields := strings.SplitN(record, logFieldSeparator, 8)
+	if len(fields) != 8 {
+		return LogEntry{}, false
+	}
+
+	authorDate, _ := time.Parse(time.RFC3339, fields[5])
+	return LogEntry{
+		Hash:       fields[0],
+		AbbrevHash: fields[0][:7],
+		Message:    strings.TrimRight(cleanLineEndings(fields[6]), "\n"),
+		Author:     Person{Name: fields[1], Email: fields[2]},
+		AuthorDate: authorDate,
+		Committer:  Person{Name: fields[3], Email: fields[4]},
+		Body:       strings.TrimSpace(cleanLineEndings(fields[7])),
+	}, true
+}
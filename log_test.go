@@ -2,9 +2,13 @@ package git_test
 
 import (
 	"bufio"
+	"context"
+	"fmt"
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	git "github.com/purpleclay/gitz"
 	"github.com/purpleclay/gitz/gittest"
@@ -220,6 +224,39 @@ feat: build exciting new library`
 	}
 }
 
+func TestCommitsBetween(t *testing.T) {
+	log := `(tag: 0.2.0) feat: add ability to filter on results
+(tag: 0.1.1) fix: unexpected bytes in message while parsing
+docs: update documentation to include fix
+(tag: 0.1.0) docs: create initial mkdocs material documentation
+feat: build exciting new library`
+	gittest.InitRepository(t, gittest.WithLog(log))
+
+	client, _ := git.NewClient()
+	commits, err := client.CommitsBetween("0.1.0", "0.2.0")
+	require.NoError(t, err)
+
+	require.Len(t, commits, 3)
+	assert.Equal(t, "feat: add ability to filter on results", commits[0].Message)
+	assert.Equal(t, "fix: unexpected bytes in message while parsing", commits[1].Message)
+	assert.Equal(t, "docs: update documentation to include fix", commits[2].Message)
+}
+
+func TestCommitsBetweenDivergedBranches(t *testing.T) {
+	gittest.InitRepository(t)
+	gittest.CreateBranch(t, "feature")
+	gittest.CommitEmpty(t, "docs: this commit is only on the trunk")
+	gittest.Checkout(t, "feature")
+	gittest.CommitEmpty(t, "feat: this commit is only on the feature branch")
+
+	client, _ := git.NewClient()
+	commits, err := client.CommitsBetween(gittest.DefaultBranch, "feature")
+	require.NoError(t, err)
+
+	require.Len(t, commits, 1)
+	assert.Equal(t, "feat: this commit is only on the feature branch", commits[0].Message)
+}
+
 func TestLogWithPaths(t *testing.T) {
 	gittest.InitRepository(t,
 		gittest.WithLocalCommits("this should not appear in the log"),
@@ -251,6 +288,31 @@ func overwriteFile(t *testing.T, path, content string) {
 	require.NoError(t, fi.Sync())
 }
 
+// conflictingBranches creates a feature branch and the default branch with
+// diverging changes to each of the given paths. The default branch is left
+// checked out, ensuring a merge, rebase or cherry-pick of the feature branch
+// will conflict. The hash of the commit on the feature branch is returned
+func conflictingBranches(t *testing.T, paths ...string) string {
+	t.Helper()
+
+	gittest.Exec(t, "git checkout -b feature")
+	for _, path := range paths {
+		overwriteFile(t, path, "changed on feature")
+		gittest.StageFile(t, path)
+	}
+	gittest.Commit(t, "fix: change on feature")
+	hash := gittest.LastCommit(t).Hash
+
+	gittest.Checkout(t, gittest.DefaultBranch)
+	for _, path := range paths {
+		overwriteFile(t, path, "changed on main")
+		gittest.StageFile(t, path)
+	}
+	gittest.Commit(t, "fix: change on main")
+
+	return hash
+}
+
 func TestLogWithSkip(t *testing.T) {
 	log := `feat: add options to support skipping of log entries
 ci: improve github workflow
@@ -433,3 +495,326 @@ chore(deps): bump dependabot/fetch-metadata from 1.3.5 to 1.3.6`
 	assert.Contains(t, out.Raw, "chore(deps): bump dependabot/fetch-metadata from 1.3.5 to 1.3.6")
 	assert.Contains(t, out.Raw, gittest.InitialCommit)
 }
+
+func TestLogWithLogAuthor(t *testing.T) {
+	gittest.InitRepository(t)
+
+	client, _ := git.NewClient()
+	_, err := client.Commit("feat: a change by joker",
+		git.WithAllowEmpty(), git.WithAuthor("joker", "joker@dc.com"))
+	require.NoError(t, err)
+
+	_, err = client.Commit("feat: a change by harley",
+		git.WithAllowEmpty(), git.WithAuthor("harley", "harley@dc.com"))
+	require.NoError(t, err)
+
+	out, err := client.Log(git.WithLogAuthor("joker"))
+	require.NoError(t, err)
+
+	require.Len(t, out.Commits, 1)
+	assert.Equal(t, "feat: a change by joker", out.Commits[0].Message)
+
+	out, err = client.Log(git.WithLogAuthor("joker", "harley@dc.com"))
+	require.NoError(t, err)
+	assert.Len(t, out.Commits, 2)
+}
+
+func TestLogWithLogCommitter(t *testing.T) {
+	gittest.InitRepository(t)
+
+	client, _ := git.NewClient(git.WithEnv(map[string]string{
+		"GIT_COMMITTER_NAME":  "penguin",
+		"GIT_COMMITTER_EMAIL": "penguin@dc.com",
+	}))
+	_, err := client.Commit("feat: a change committed by penguin", git.WithAllowEmpty())
+	require.NoError(t, err)
+
+	out, err := client.Log(git.WithLogCommitter("penguin@dc.com"))
+	require.NoError(t, err)
+
+	require.Len(t, out.Commits, 1)
+	assert.Equal(t, "feat: a change committed by penguin", out.Commits[0].Message)
+}
+
+func TestLogWithMergeFilters(t *testing.T) {
+	log := `(HEAD -> feature) feat: a brand new feature
+(main, origin/main) chore: prepare for the next feature`
+	gittest.InitRepository(t, gittest.WithLog(log))
+	gittest.Checkout(t, gittest.DefaultBranch)
+
+	client, _ := git.NewClient()
+	_, err := client.Merge("feature",
+		git.WithNoFastForward(),
+		git.WithMergeMessage("Merge the brand new feature"))
+	require.NoError(t, err)
+
+	out, err := client.Log(git.WithMergesOnly())
+	require.NoError(t, err)
+	require.Len(t, out.Commits, 1)
+	assert.Equal(t, "Merge the brand new feature", out.Commits[0].Message)
+
+	out, err = client.Log(git.WithNoMerges())
+	require.NoError(t, err)
+	require.Len(t, out.Commits, 3)
+	for _, commit := range out.Commits {
+		assert.NotEqual(t, "Merge the brand new feature", commit.Message)
+	}
+}
+
+func TestLogWithFirstParent(t *testing.T) {
+	log := `(HEAD -> feature) feat: a brand new feature
+(main, origin/main) chore: prepare for the next feature`
+	gittest.InitRepository(t, gittest.WithLog(log))
+	gittest.Checkout(t, gittest.DefaultBranch)
+
+	client, _ := git.NewClient()
+	_, err := client.Merge("feature",
+		git.WithNoFastForward(),
+		git.WithMergeMessage("Merge the brand new feature"))
+	require.NoError(t, err)
+
+	out, err := client.Log(git.WithFirstParent())
+	require.NoError(t, err)
+
+	require.Len(t, out.Commits, 3)
+	assert.Equal(t, "Merge the brand new feature", out.Commits[0].Message)
+	assert.Equal(t, "chore: prepare for the next feature", out.Commits[1].Message)
+	assert.Equal(t, gittest.InitialCommit, out.Commits[2].Message)
+}
+
+func TestLogWithExtendedFields(t *testing.T) {
+	gittest.InitRepository(t)
+	date := time.Date(2023, time.March, 14, 9, 30, 0, 0, time.FixedZone("", 3600))
+
+	client, _ := git.NewClient()
+	_, err := client.Commit("feat: a change with extended fields",
+		git.WithAllowEmpty(),
+		git.WithAuthor("joker", "joker@dc.com"),
+		git.WithCommitDate(date),
+		git.WithCommitBody("the first paragraph\nspans multiple lines", "the second paragraph"))
+	require.NoError(t, err)
+
+	out, err := client.Log(git.WithExtendedFields(), git.WithTake(1))
+	require.NoError(t, err)
+
+	require.Len(t, out.Commits, 1)
+	entry := out.Commits[0]
+	assert.Equal(t, gittest.LastCommit(t).Hash, entry.Hash)
+	assert.Equal(t, entry.Hash[:7], entry.AbbrevHash)
+	assert.Equal(t, `feat: a change with extended fields
+
+the first paragraph
+spans multiple lines
+
+the second paragraph`, entry.Message)
+	assert.Equal(t, `the first paragraph
+spans multiple lines
+
+the second paragraph`, entry.Body)
+	assert.Equal(t, git.Person{Name: "joker", Email: "joker@dc.com"}, entry.Author)
+	assert.True(t, date.Equal(entry.AuthorDate))
+	assert.Equal(t, git.Person{Name: gittest.DefaultAuthorName, Email: gittest.DefaultAuthorEmail}, entry.Committer)
+}
+
+func TestLogWithExtendedFieldsMultipleEntries(t *testing.T) {
+	log := `feat: the second commit
+feat: the first commit`
+	gittest.InitRepository(t, gittest.WithLog(log))
+
+	client, _ := git.NewClient()
+	out, err := client.Log(git.WithExtendedFields())
+	require.NoError(t, err)
+
+	require.Len(t, out.Commits, 3)
+	assert.Equal(t, "feat: the second commit", out.Commits[0].Message)
+	assert.Equal(t, "feat: the first commit", out.Commits[1].Message)
+	assert.Equal(t, gittest.InitialCommit, out.Commits[2].Message)
+	assert.Empty(t, out.Commits[0].Body)
+}
+
+func TestLogWithFollow(t *testing.T) {
+	gittest.InitRepository(t)
+	gittest.StagedFile(t, "old.txt", "content that survives a rename")
+	gittest.Commit(t, "feat: add a brand new file")
+	gittest.Move(t, "old.txt", "new.txt")
+	gittest.Commit(t, "refactor: rename the file")
+
+	client, _ := git.NewClient()
+	out, err := client.Log(git.WithPaths("new.txt"))
+	require.NoError(t, err)
+	require.Len(t, out.Commits, 1)
+
+	out, err = client.Log(git.WithPaths("new.txt"), git.WithFollow())
+	require.NoError(t, err)
+
+	require.Len(t, out.Commits, 2)
+	assert.Equal(t, "refactor: rename the file", out.Commits[0].Message)
+	assert.Equal(t, "feat: add a brand new file", out.Commits[1].Message)
+}
+
+func TestLogWithReverse(t *testing.T) {
+	log := `feat: the third commit
+feat: the second commit
+feat: the first commit`
+	gittest.InitRepository(t, gittest.WithLog(log))
+
+	client, _ := git.NewClient()
+	out, err := client.Log(git.WithReverse())
+	require.NoError(t, err)
+
+	require.Len(t, out.Commits, 4)
+	assert.Equal(t, gittest.InitialCommit, out.Commits[0].Message)
+	assert.Equal(t, "feat: the third commit", out.Commits[3].Message)
+}
+
+func TestLogWithReverseAndTake(t *testing.T) {
+	log := `feat: the third commit
+feat: the second commit
+feat: the first commit`
+	gittest.InitRepository(t, gittest.WithLog(log))
+
+	client, _ := git.NewClient()
+	out, err := client.Log(git.WithReverse(), git.WithTake(2))
+	require.NoError(t, err)
+
+	// The most recent commits are taken before being reversed
+	require.Len(t, out.Commits, 2)
+	assert.Equal(t, "feat: the second commit", out.Commits[0].Message)
+	assert.Equal(t, "feat: the third commit", out.Commits[1].Message)
+}
+
+func TestLogStream(t *testing.T) {
+	var log strings.Builder
+	for i := 300; i > 0; i-- {
+		log.WriteString(fmt.Sprintf("feat: commit number %d\n", i))
+	}
+	gittest.InitRepository(t, gittest.WithLog(log.String()))
+
+	client, _ := git.NewClient()
+	expected, err := client.Log()
+	require.NoError(t, err)
+
+	entries, errs := client.LogStream(context.Background())
+
+	var streamed []git.LogEntry
+	for entry := range entries {
+		streamed = append(streamed, entry)
+	}
+	require.NoError(t, <-errs)
+
+	require.Len(t, streamed, 301)
+	assert.Equal(t, expected.Commits, streamed)
+}
+
+func TestLogStreamWithExtendedFields(t *testing.T) {
+	log := `feat: the second commit
+feat: the first commit`
+	gittest.InitRepository(t, gittest.WithLog(log))
+
+	client, _ := git.NewClient()
+	expected, err := client.Log(git.WithExtendedFields())
+	require.NoError(t, err)
+
+	entries, errs := client.LogStream(context.Background(), git.WithExtendedFields())
+
+	var streamed []git.LogEntry
+	for entry := range entries {
+		streamed = append(streamed, entry)
+	}
+	require.NoError(t, <-errs)
+
+	assert.Equal(t, expected.Commits, streamed)
+}
+
+func TestLogStreamCancelled(t *testing.T) {
+	var log strings.Builder
+	for i := 300; i > 0; i-- {
+		log.WriteString(fmt.Sprintf("feat: commit number %d\n", i))
+	}
+	gittest.InitRepository(t, gittest.WithLog(log.String()))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	client, _ := git.NewClient()
+	entries, errs := client.LogStream(ctx)
+
+	for i := 0; i < 150; i++ {
+		<-entries
+	}
+	cancel()
+
+	// Both channels must be closed, without blocking, once cancelled
+	for range entries {
+	}
+	require.ErrorIs(t, <-errs, context.Canceled)
+}
+
+func TestLogStreamLargeCommitMessage(t *testing.T) {
+	gittest.InitRepository(t)
+	msg := filepath.Join(t.TempDir(), "message.txt")
+	body := strings.Repeat("the batmobile needs more gadgets ", 4096)
+	require.NoError(t, os.WriteFile(msg, []byte("feat: large commit\n\n"+body), 0o644))
+	gittest.MustExec(t, fmt.Sprintf("git commit --allow-empty -F '%s'", msg))
+
+	client, _ := git.NewClient()
+	entries, errs := client.LogStream(context.Background(), git.WithTake(1))
+
+	var streamed []git.LogEntry
+	for entry := range entries {
+		streamed = append(streamed, entry)
+	}
+	require.NoError(t, <-errs)
+
+	require.Len(t, streamed, 1)
+	assert.Contains(t, streamed[0].Message, "the batmobile needs more gadgets")
+}
+
+func TestLogStreamError(t *testing.T) {
+	nonWorkingDirectory(t)
+
+	client, _ := git.NewClient()
+	entries, errs := client.LogStream(context.Background())
+
+	for range entries {
+		t.Fatal("no entries should be streamed")
+	}
+	require.ErrorAs(t, <-errs, &git.ErrGitExecCommand{})
+}
+
+func TestLogWithPrettyFormat(t *testing.T) {
+	log := `feat: the second commit
+feat: the first commit`
+	gittest.InitRepository(t, gittest.WithLog(log))
+
+	client, _ := git.NewClient()
+	out, err := client.Log(git.WithPrettyFormat("%h|%an|%s"))
+	require.NoError(t, err)
+
+	assert.Empty(t, out.Commits)
+
+	lines := strings.Split(out.Raw, "\n")
+	require.Len(t, lines, 3)
+	for i, msg := range []string{"feat: the second commit", "feat: the first commit", gittest.InitialCommit} {
+		assert.Regexp(t, fmt.Sprintf(`^[0-9a-f]{7,}\|%s\|%s$`, gittest.DefaultAuthorName, msg), lines[i])
+	}
+}
+
+func TestLogWithPrettyFormatAndLogParser(t *testing.T) {
+	log := `feat: the second commit
+feat: the first commit`
+	gittest.InitRepository(t, gittest.WithLog(log))
+
+	client, _ := git.NewClient()
+	out, err := client.Log(git.WithPrettyFormat("%H|%s"), git.WithLogParser(func(raw string) []git.LogEntry {
+		var entries []git.LogEntry
+		for _, line := range strings.Split(raw, "\n") {
+			hash, msg, _ := strings.Cut(line, "|")
+			entries = append(entries, git.LogEntry{Hash: hash, AbbrevHash: hash[:7], Message: msg})
+		}
+		return entries
+	}))
+	require.NoError(t, err)
+
+	expected, err := client.Log()
+	require.NoError(t, err)
+	assert.Equal(t, expected.Commits, out.Commits)
+}
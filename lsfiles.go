@@ -0,0 +1,106 @@
+package git
+
+import (
+	"bufio"
+	"fmt"
+	"strings"
+
+	"github.com/purpleclay/gitz/scan"
+)
+
+// LsFilesOption provides a way for setting specific options when listing
+// files within the current repository (working directory). Each supported
+// option can customize the files that are retrieved
+type LsFilesOption func(*lsFilesOptions)
+
+type lsFilesOptions struct {
+	Cached    bool
+	Modified  bool
+	Others    bool
+	PathSpecs []string
+}
+
+// WithLsFilesCached lists all files tracked within the index. This is
+// the default behavior, unless another file type is requested
+func WithLsFilesCached() LsFilesOption {
+	return func(opts *lsFilesOptions) {
+		opts.Cached = true
+	}
+}
+
+// WithLsFilesModified lists all tracked files that have been modified
+// within the working tree
+func WithLsFilesModified() LsFilesOption {
+	return func(opts *lsFilesOptions) {
+		opts.Modified = true
+	}
+}
+
+// WithLsFilesOthers lists all untracked files within the working tree.
+// Any files excluded by .gitignore will not be listed
+func WithLsFilesOthers() LsFilesOption {
+	return func(opts *lsFilesOptions) {
+		opts.Others = true
+	}
+}
+
+// WithLsFilesPathSpecs permits a series of [PathSpecs] (or globs) to be
+// defined that will limit the listed files to those that match. Paths
+// to files and folders are relative to the root of the repository. All
+// leading and trailing whitespace will be trimmed from the file paths,
+// allowing empty paths to be ignored
+//
+// [PathSpecs]: https://git-scm.com/docs/gitglossary#Documentation/gitglossary.txt-aiddefpathspecapathspec
+func WithLsFilesPathSpecs(specs ...string) LsFilesOption {
+	return func(opts *lsFilesOptions) {
+		opts.PathSpecs = trim(specs...)
+	}
+}
+
+// LsFiles lists files within the current repository (working directory).
+// By default, all files tracked within the index are listed. Paths are
+// relative to the root of the repository
+func (c *Client) LsFiles(opts ...LsFilesOption) ([]string, error) {
+	options := &lsFilesOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	var buf strings.Builder
+	buf.WriteString("git ls-files --full-name -z")
+
+	if options.Cached {
+		buf.WriteString(" --cached")
+	}
+
+	if options.Modified {
+		buf.WriteString(" --modified")
+	}
+
+	if options.Others {
+		buf.WriteString(" --others --exclude-standard")
+	}
+
+	if len(options.PathSpecs) > 0 {
+		buf.WriteString(" --")
+		for _, spec := range options.PathSpecs {
+			buf.WriteString(fmt.Sprintf(" '%s'", escapeQuotes(spec)))
+		}
+	}
+
+	out, err := c.Exec(buf.String())
+	if err != nil {
+		return nil, err
+	}
+
+	var files []string
+
+	scanner := bufio.NewScanner(strings.NewReader(out))
+	scanner.Split(scan.NullTerminatedLines())
+
+	for scanner.Scan() {
+		files = append(files, scanner.Text())
+	}
+
+	return files, nil
+}
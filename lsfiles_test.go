@@ -0,0 +1,85 @@
+package git_test
+
+import (
+	"testing"
+
+	git "github.com/purpleclay/gitz"
+	"github.com/purpleclay/gitz/gittest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLsFiles(t *testing.T) {
+	gittest.InitRepository(t,
+		gittest.WithCommittedFiles("main.go", "internal/parser.go", "docs/index.md"),
+		gittest.WithFiles("untracked.txt"))
+
+	client, _ := git.NewClient()
+	files, err := client.LsFiles()
+
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"README.md", "main.go", "internal/parser.go", "docs/index.md"}, files)
+}
+
+func TestLsFilesWithLsFilesOthers(t *testing.T) {
+	gittest.InitRepository(t,
+		gittest.WithCommittedFiles("main.go"),
+		gittest.WithFiles("untracked.txt", "internal/untracked.go"))
+
+	client, _ := git.NewClient()
+	files, err := client.LsFiles(git.WithLsFilesOthers())
+
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"untracked.txt", "internal/untracked.go"}, files)
+}
+
+func TestLsFilesWithLsFilesModified(t *testing.T) {
+	gittest.InitRepository(t, gittest.WithCommittedFiles("main.go", "parser.go"))
+	overwriteFile(t, "parser.go", "a modification to the parser")
+
+	client, _ := git.NewClient()
+	files, err := client.LsFiles(git.WithLsFilesModified())
+
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"parser.go"}, files)
+}
+
+func TestLsFilesWithLsFilesPathSpecs(t *testing.T) {
+	gittest.InitRepository(t, gittest.WithCommittedFiles("main.go", "internal/parser.go", "docs/index.md"))
+
+	client, _ := git.NewClient()
+	files, err := client.LsFiles(git.WithLsFilesCached(), git.WithLsFilesPathSpecs("*.go"))
+
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"main.go", "internal/parser.go"}, files)
+}
+
+func TestLsFilesNoMatches(t *testing.T) {
+	gittest.InitRepository(t)
+
+	client, _ := git.NewClient()
+	files, err := client.LsFiles(git.WithLsFilesOthers())
+
+	require.NoError(t, err)
+	assert.Empty(t, files)
+}
+
+func TestLsFilesSpecialCharacters(t *testing.T) {
+	gittest.InitRepository(t, gittest.WithFiles("new\nline.go", "tab\tbed.go", "it's quoted.go"))
+
+	client, _ := git.NewClient()
+	files, err := client.LsFiles(git.WithLsFilesOthers())
+
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"new\nline.go", "tab\tbed.go", "it's quoted.go"}, files)
+}
+
+func TestLsFilesWithLsFilesPathSpecsQuoted(t *testing.T) {
+	gittest.InitRepository(t, gittest.WithFiles("it's quoted.go", "unquoted.go"))
+
+	client, _ := git.NewClient()
+	files, err := client.LsFiles(git.WithLsFilesOthers(), git.WithLsFilesPathSpecs("it's*"))
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"it's quoted.go"}, files)
+}
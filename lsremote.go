@@ -0,0 +1,103 @@
+package git
+
+import (
+	"fmt"
+	"strings"
+)
+
+// LsRemoteOption provides a way for setting specific options when listing
+// references within a remote repository. Each supported option can
+// customize the references that are retrieved
+type LsRemoteOption func(*lsRemoteOptions)
+
+type lsRemoteOptions struct {
+	Heads    bool
+	Patterns []string
+	Tags     bool
+}
+
+// WithLsRemoteHeads limits the retrieved references to branches only
+func WithLsRemoteHeads() LsRemoteOption {
+	return func(opts *lsRemoteOptions) {
+		opts.Heads = true
+	}
+}
+
+// WithLsRemotePatterns limits the retrieved references to those that
+// match any of the provided patterns. A pattern is matched against the
+// end of each reference, for example, main will match refs/heads/main.
+// All leading and trailing whitespace will be trimmed, allowing empty
+// patterns to be ignored
+func WithLsRemotePatterns(patterns ...string) LsRemoteOption {
+	return func(opts *lsRemoteOptions) {
+		opts.Patterns = trim(patterns...)
+	}
+}
+
+// WithLsRemoteTags limits the retrieved references to tags only
+func WithLsRemoteTags() LsRemoteOption {
+	return func(opts *lsRemoteOptions) {
+		opts.Tags = true
+	}
+}
+
+// RemoteRef represents a reference within a remote repository
+type RemoteRef struct {
+	// Hash of the object the reference points to
+	Hash string
+
+	// Ref contains the fully qualified name of the reference,
+	// for example refs/heads/main
+	Ref string
+}
+
+// LsRemote lists references within a remote repository, identified by
+// either its URL or the name of a tracked remote, without cloning it.
+// Raw output is parsed from the following command:
+//
+//	git ls-remote '<url>'
+func (c *Client) LsRemote(url string, opts ...LsRemoteOption) ([]RemoteRef, error) {
+	options := &lsRemoteOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	var buf strings.Builder
+	buf.WriteString("git ls-remote")
+
+	if options.Heads {
+		buf.WriteString(" --heads")
+	}
+
+	if options.Tags {
+		buf.WriteString(" --tags")
+	}
+
+	buf.WriteString(fmt.Sprintf(" '%s'", escapeQuotes(strings.TrimSpace(url))))
+
+	for _, pattern := range options.Patterns {
+		buf.WriteString(fmt.Sprintf(" '%s'", escapeQuotes(pattern)))
+	}
+
+	out, err := c.Exec(buf.String())
+	if err != nil {
+		return nil, err
+	}
+
+	return parseRemoteRefs(out), nil
+}
+
+func parseRemoteRefs(out string) []RemoteRef {
+	if out == "" {
+		return nil
+	}
+
+	var refs []RemoteRef
+	for _, line := range strings.Split(out, "\n") {
+		if hash, ref, found := strings.Cut(line, "\t"); found {
+			refs = append(refs, RemoteRef{Hash: hash, Ref: ref})
+		}
+	}
+
+	return refs
+}
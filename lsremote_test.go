@@ -0,0 +1,69 @@
+package git_test
+
+import (
+	"testing"
+
+	git "github.com/purpleclay/gitz"
+	"github.com/purpleclay/gitz/gittest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLsRemote(t *testing.T) {
+	log := "(tag: 0.1.0, main, origin/main) feat: inspect the remote without cloning"
+	gittest.InitRepository(t, gittest.WithLog(log))
+	hash := gittest.LastCommit(t).Hash
+
+	client, _ := git.NewClient()
+	refs, err := client.LsRemote(gittest.Remote(t))
+	require.NoError(t, err)
+
+	assert.Contains(t, refs, git.RemoteRef{Hash: hash, Ref: "refs/heads/" + gittest.DefaultBranch})
+	assert.Contains(t, refs, git.RemoteRef{Hash: hash, Ref: "refs/tags/0.1.0"})
+}
+
+func TestLsRemoteWithLsRemoteHeads(t *testing.T) {
+	log := "(tag: 0.1.0, main, origin/main) feat: inspect the remote without cloning"
+	gittest.InitRepository(t, gittest.WithLog(log))
+
+	client, _ := git.NewClient()
+	refs, err := client.LsRemote(gittest.DefaultOrigin, git.WithLsRemoteHeads())
+	require.NoError(t, err)
+
+	require.Len(t, refs, 1)
+	assert.Equal(t, "refs/heads/"+gittest.DefaultBranch, refs[0].Ref)
+}
+
+func TestLsRemoteWithLsRemoteTagsAndPatterns(t *testing.T) {
+	log := "(tag: 0.1.0, tag: 0.2.0, main, origin/main) feat: inspect the remote without cloning"
+	gittest.InitRepository(t, gittest.WithLog(log))
+
+	client, _ := git.NewClient()
+	refs, err := client.LsRemote(gittest.DefaultOrigin,
+		git.WithLsRemoteTags(),
+		git.WithLsRemotePatterns("0.2.0"))
+	require.NoError(t, err)
+
+	require.Len(t, refs, 1)
+	assert.Equal(t, "refs/tags/0.2.0", refs[0].Ref)
+}
+
+func TestLsRemoteNoMatches(t *testing.T) {
+	gittest.InitRepository(t)
+
+	client, _ := git.NewClient()
+	refs, err := client.LsRemote(gittest.DefaultOrigin, git.WithLsRemoteTags())
+
+	require.NoError(t, err)
+	assert.Empty(t, refs)
+}
+
+func TestLsRemoteQuotedPattern(t *testing.T) {
+	gittest.InitRepository(t)
+
+	client, _ := git.NewClient()
+	refs, err := client.LsRemote(gittest.DefaultOrigin, git.WithLsRemotePatterns("it's"))
+
+	require.NoError(t, err)
+	assert.Empty(t, refs)
+}
@@ -0,0 +1,111 @@
+package git
+
+import (
+	"fmt"
+	"strings"
+)
+
+// MergeOption provides a way for setting specific options during a merge
+// operation. Each supported option can customize the way a reference is
+// merged into the current repository (working directory)
+type MergeOption func(*mergeOptions)
+
+type mergeOptions struct {
+	Config          []string
+	FastForwardOnly bool
+	Message         string
+	NoFastForward   bool
+	Squash          bool
+}
+
+// WithFastForwardOnly ensures the merge only succeeds if the current
+// branch can be fast-forwarded to the reference being merged. If the
+// histories have diverged, the merge is refused
+func WithFastForwardOnly() MergeOption {
+	return func(opts *mergeOptions) {
+		opts.FastForwardOnly = true
+	}
+}
+
+// WithMergeConfig allows temporary git config to be set while merging
+// a reference. Config set using this approach will override any config
+// defined within existing git config files. Config must be provided as
+// key value pairs, mismatched config will result in an [ErrMissingConfigValue]
+// error. Any invalid paths will result in an [ErrInvalidConfigPath] error
+func WithMergeConfig(kv ...string) MergeOption {
+	return func(opts *mergeOptions) {
+		opts.Config = trim(kv...)
+	}
+}
+
+// WithMergeMessage overrides the default message assigned to the merge
+// commit. Any leading and trailing whitespace will automatically be
+// trimmed from the message. This allows empty messages to be ignored
+func WithMergeMessage(msg string) MergeOption {
+	return func(opts *mergeOptions) {
+		opts.Message = strings.TrimSpace(msg)
+	}
+}
+
+// WithNoFastForward ensures a merge commit is always created, even
+// when the current branch could be fast-forwarded
+func WithNoFastForward() MergeOption {
+	return func(opts *mergeOptions) {
+		opts.NoFastForward = true
+	}
+}
+
+// WithSquash combines all changes from the reference being merged into
+// the index and working tree, without creating a merge commit. A separate
+// commit is needed to record the changes
+func WithSquash() MergeOption {
+	return func(opts *mergeOptions) {
+		opts.Squash = true
+	}
+}
+
+// Merge incorporates changes from a named reference, typically a branch, into
+// the current branch of the repository (working directory). By default, git will
+// fast-forward the current branch if possible, otherwise a merge commit is
+// created. If a conflict occurs, an [ErrGitExecCommand] error is returned,
+// containing the raw git output, which will identify any conflicting files
+func (c *Client) Merge(ref string, opts ...MergeOption) (string, error) {
+	options := &mergeOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	cfg, err := ToInlineConfig(options.Config...)
+	if err != nil {
+		return "", err
+	}
+
+	var buf strings.Builder
+	buf.WriteString("git")
+
+	if len(cfg) > 0 {
+		buf.WriteString(" ")
+		buf.WriteString(strings.Join(cfg, " "))
+	}
+	buf.WriteString(" merge")
+
+	if options.NoFastForward {
+		buf.WriteString(" --no-ff")
+	}
+
+	if options.FastForwardOnly {
+		buf.WriteString(" --ff-only")
+	}
+
+	if options.Squash {
+		buf.WriteString(" --squash")
+	}
+
+	if options.Message != "" {
+		buf.WriteString(fmt.Sprintf(" -m '%s'", escapeQuotes(options.Message)))
+	}
+
+	buf.WriteString(" ")
+	buf.WriteString(strings.TrimSpace(ref))
+	return c.Exec(buf.String())
+}
@@ -0,0 +1,79 @@
+package git_test
+
+import (
+	"strings"
+	"testing"
+
+	git "github.com/purpleclay/gitz"
+	"github.com/purpleclay/gitz/gittest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMerge(t *testing.T) {
+	log := `(HEAD -> feature) feat: a brand new feature
+(main, origin/main) chore: prepare for the next feature`
+	gittest.InitRepository(t, gittest.WithLog(log))
+	gittest.Checkout(t, gittest.DefaultBranch)
+
+	client, _ := git.NewClient()
+	_, err := client.Merge("feature")
+	require.NoError(t, err)
+
+	localLog := gittest.Log(t)
+	assert.Equal(t, "feat: a brand new feature", localLog[0].Message)
+}
+
+func TestMergeWithNoFastForward(t *testing.T) {
+	log := `(HEAD -> feature) feat: a brand new feature
+(main, origin/main) chore: prepare for the next feature`
+	gittest.InitRepository(t, gittest.WithLog(log))
+	gittest.Checkout(t, gittest.DefaultBranch)
+
+	client, _ := git.NewClient()
+	_, err := client.Merge("feature",
+		git.WithNoFastForward(),
+		git.WithMergeMessage("Merge the brand new feature"))
+	require.NoError(t, err)
+
+	assert.Equal(t, "Merge the brand new feature", gittest.LastCommit(t).Message)
+	parents := gittest.MustExec(t, "git rev-list --parents -n1 HEAD")
+	assert.Len(t, strings.Fields(parents), 3)
+}
+
+func TestMergeWithSquash(t *testing.T) {
+	gittest.InitRepository(t)
+	gittest.Exec(t, "git checkout -b feature")
+	gittest.StagedFile(t, "feature.txt", "a brand new feature")
+	gittest.Commit(t, "feat: a brand new feature")
+	gittest.Checkout(t, gittest.DefaultBranch)
+
+	client, _ := git.NewClient()
+	_, err := client.Merge("feature", git.WithSquash())
+	require.NoError(t, err)
+
+	assert.Equal(t, gittest.InitialCommit, gittest.LastCommit(t).Message)
+	assert.ElementsMatch(t, []string{"A  feature.txt"}, gittest.PorcelainStatus(t))
+}
+
+func TestMergeWithFastForwardOnlyDivergedError(t *testing.T) {
+	gittest.InitRepository(t)
+	gittest.Exec(t, "git checkout -b feature")
+	gittest.CommitEmpty(t, "feat: a brand new feature")
+	gittest.Checkout(t, gittest.DefaultBranch)
+	gittest.CommitEmpty(t, "fix: a diverging fix")
+
+	client, _ := git.NewClient()
+	_, err := client.Merge("feature", git.WithFastForwardOnly())
+	require.ErrorAs(t, err, &git.ErrGitExecCommand{})
+}
+
+func TestMergeConflict(t *testing.T) {
+	gittest.InitRepository(t, gittest.WithCommittedFiles("conflict.txt"))
+	conflictingBranches(t, "conflict.txt")
+
+	client, _ := git.NewClient()
+	_, err := client.Merge("feature")
+	require.ErrorAs(t, err, &git.ErrGitExecCommand{})
+	assert.Contains(t, err.Error(), "CONFLICT")
+}
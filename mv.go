@@ -0,0 +1,44 @@
+package git
+
+import (
+	"fmt"
+	"strings"
+)
+
+// MvOption provides a way for setting specific options while moving
+// a tracked file. Each supported option can customize how the file
+// is moved within the current repository (working directory)
+type MvOption func(*mvOptions)
+
+type mvOptions struct {
+	Force bool
+}
+
+// WithForceMv forces the move of a file, even if the destination
+// already exists. The existing destination file will be overwritten
+func WithForceMv() MvOption {
+	return func(opts *mvOptions) {
+		opts.Force = true
+	}
+}
+
+// Mv moves (or renames) a tracked file, directory or symlink within the
+// current repository (working directory). The move is staged within the
+// index, ensuring it is tracked as a rename rather than a deletion and
+// addition. Paths are relative to the current working directory
+func (c *Client) Mv(src, dst string, opts ...MvOption) (string, error) {
+	options := &mvOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	var buf strings.Builder
+	buf.WriteString("git mv")
+
+	if options.Force {
+		buf.WriteString(" --force")
+	}
+	buf.WriteString(fmt.Sprintf(" '%s' '%s'", escapeQuotes(src), escapeQuotes(dst)))
+
+	return c.Exec(buf.String())
+}
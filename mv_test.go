@@ -0,0 +1,45 @@
+package git_test
+
+import (
+	"testing"
+
+	git "github.com/purpleclay/gitz"
+	"github.com/purpleclay/gitz/gittest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMv(t *testing.T) {
+	gittest.InitRepository(t, gittest.WithCommittedFiles("main.go"))
+
+	client, _ := git.NewClient()
+	_, err := client.Mv("main.go", "cmd.go")
+	require.NoError(t, err)
+
+	statuses, err := client.PorcelainStatus()
+	require.NoError(t, err)
+
+	require.Len(t, statuses, 1)
+	assert.True(t, statuses[0].Renamed())
+	assert.Equal(t, "main.go -> cmd.go", statuses[0].Path)
+}
+
+func TestMvDestinationExistsError(t *testing.T) {
+	gittest.InitRepository(t, gittest.WithCommittedFiles("main.go", "cmd.go"))
+
+	client, _ := git.NewClient()
+	_, err := client.Mv("main.go", "cmd.go")
+
+	require.ErrorAs(t, err, &git.ErrGitExecCommand{})
+}
+
+func TestMvWithForceMv(t *testing.T) {
+	gittest.InitRepository(t, gittest.WithCommittedFiles("main.go", "cmd.go"))
+
+	client, _ := git.NewClient()
+	_, err := client.Mv("main.go", "cmd.go", git.WithForceMv())
+	require.NoError(t, err)
+
+	assert.NoFileExists(t, "main.go")
+	assert.FileExists(t, "cmd.go")
+}
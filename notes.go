@@ -0,0 +1,82 @@
+package git
+
+import (
+	"fmt"
+	"strings"
+)
+
+// NoteOption provides a way for setting specific options while managing
+// notes. Each supported option can customize how notes are attached to
+// objects within the current repository (working directory)
+type NoteOption func(*noteOptions)
+
+type noteOptions struct {
+	NotesRef string
+}
+
+// WithNotesRef targets a notes ref other than the default (refs/notes/commits).
+// A partial name will be expanded by git, for example, ci will target
+// refs/notes/ci. All leading and trailing whitespace will be trimmed,
+// allowing an empty name to be ignored
+func WithNotesRef(name string) NoteOption {
+	return func(opts *noteOptions) {
+		opts.NotesRef = strings.TrimSpace(name)
+	}
+}
+
+func (o noteOptions) String() string {
+	if o.NotesRef == "" {
+		return "git notes"
+	}
+
+	return fmt.Sprintf("git notes --ref='%s'", escapeQuotes(o.NotesRef))
+}
+
+func newNoteOptions(opts ...NoteOption) *noteOptions {
+	options := &noteOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	return options
+}
+
+// AddNote attaches a note to an object, typically a commit, within the
+// current repository (working directory). Notes are stored separately
+// from the object, ensuring it remains unchanged. An empty ref will
+// attach the note to HEAD. Adding a note to an object that already has
+// one will result in an error
+func (c *Client) AddNote(ref, message string, opts ...NoteOption) (string, error) {
+	options := newNoteOptions(opts...)
+
+	return c.Exec(fmt.Sprintf("%s add -m '%s' '%s'",
+		options, escapeQuotes(message), noteRef(ref)))
+}
+
+// ShowNote retrieves the note attached to an object, typically a commit,
+// within the current repository (working directory). An empty ref will
+// retrieve the note attached to HEAD. An error is returned if no note
+// exists
+func (c *Client) ShowNote(ref string, opts ...NoteOption) (string, error) {
+	options := newNoteOptions(opts...)
+
+	return c.Exec(fmt.Sprintf("%s show '%s'", options, noteRef(ref)))
+}
+
+// RemoveNote removes the note attached to an object, typically a commit,
+// within the current repository (working directory). An empty ref will
+// remove the note attached to HEAD. An error is returned if no note
+// exists
+func (c *Client) RemoveNote(ref string, opts ...NoteOption) (string, error) {
+	options := newNoteOptions(opts...)
+
+	return c.Exec(fmt.Sprintf("%s remove '%s'", options, noteRef(ref)))
+}
+
+func noteRef(ref string) string {
+	rev := strings.TrimSpace(ref)
+	if rev == "" {
+		return "HEAD"
+	}
+	return escapeQuotes(rev)
+}
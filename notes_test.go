@@ -0,0 +1,50 @@
+package git_test
+
+import (
+	"testing"
+
+	git "github.com/purpleclay/gitz"
+	"github.com/purpleclay/gitz/gittest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddNote(t *testing.T) {
+	gittest.InitRepository(t)
+	hash := gittest.LastCommit(t).Hash
+
+	client, _ := git.NewClient()
+	_, err := client.AddNote(hash, "build: passed")
+	require.NoError(t, err)
+
+	note, err := client.ShowNote(hash)
+	require.NoError(t, err)
+	assert.Equal(t, "build: passed", note)
+}
+
+func TestAddNoteWithNotesRef(t *testing.T) {
+	gittest.InitRepository(t)
+
+	client, _ := git.NewClient()
+	_, err := client.AddNote("HEAD", "coverage: 98%", git.WithNotesRef("ci"))
+	require.NoError(t, err)
+
+	note, err := client.ShowNote("HEAD", git.WithNotesRef("ci"))
+	require.NoError(t, err)
+	assert.Equal(t, "coverage: 98%", note)
+
+	_, err = client.ShowNote("HEAD")
+	require.Error(t, err)
+}
+
+func TestRemoveNote(t *testing.T) {
+	gittest.InitRepository(t)
+	gittest.MustExec(t, "git notes add -m 'build: passed'")
+
+	client, _ := git.NewClient()
+	_, err := client.RemoveNote("HEAD")
+	require.NoError(t, err)
+
+	_, err = client.ShowNote("HEAD")
+	require.ErrorAs(t, err, &git.ErrGitExecCommand{})
+}
@@ -0,0 +1,171 @@
+package git
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ApplyOption provides a way for setting specific options while applying
+// a patch. Each supported option can customize how the patch is applied
+// to the current repository (working directory)
+type ApplyOption func(*applyOptions)
+
+type applyOptions struct {
+	Check    bool
+	Reverse  bool
+	ThreeWay bool
+}
+
+// WithApplyCheck checks if the patch can be applied cleanly, without
+// applying it
+func WithApplyCheck() ApplyOption {
+	return func(opts *applyOptions) {
+		opts.Check = true
+	}
+}
+
+// WithApply3Way attempts a three-way merge if the patch does not apply
+// cleanly, using the blob information recorded within the patch. Any
+// conflicts will be left within the working tree for resolution
+func WithApply3Way() ApplyOption {
+	return func(opts *applyOptions) {
+		opts.ThreeWay = true
+	}
+}
+
+// WithApplyReverse applies the patch in reverse, undoing its changes
+func WithApplyReverse() ApplyOption {
+	return func(opts *applyOptions) {
+		opts.Reverse = true
+	}
+}
+
+// ApplyPatch applies a patch, such as the raw output of git diff, to the
+// current repository (working directory). The patch is read by git from
+// stdin. The following command is executed:
+//
+//	git apply -
+func (c *Client) ApplyPatch(patch string, opts ...ApplyOption) (string, error) {
+	options := &applyOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	var buf strings.Builder
+	buf.WriteString("git apply")
+
+	if options.Check {
+		buf.WriteString(" --check")
+	}
+
+	if options.ThreeWay {
+		buf.WriteString(" --3way")
+	}
+
+	if options.Reverse {
+		buf.WriteString(" -R")
+	}
+	buf.WriteString(" -")
+
+	// Ensure the patch is terminated by a newline, otherwise git will
+	// report it as corrupt
+	if !strings.HasSuffix(patch, "\n") {
+		patch += "\n"
+	}
+
+	return c.execWithStdin(buf.String(), patch)
+}
+
+// FormatPatchOption provides a way for setting specific options while
+// formatting commits as patches. Each supported option can customize
+// the content of each generated patch
+type FormatPatchOption func(*formatPatchOptions)
+
+type formatPatchOptions struct {
+	Numbered bool
+	Signoff  bool
+}
+
+// WithFormatPatchNumbered numbers each patch within the subject line,
+// using the [PATCH n/m] format, even if only a single patch is generated
+func WithFormatPatchNumbered() FormatPatchOption {
+	return func(opts *formatPatchOptions) {
+		opts.Numbered = true
+	}
+}
+
+// WithFormatPatchSignoff appends a Signed-off-by trailer to the commit
+// message of each patch, using the identity of the current committer
+func WithFormatPatchSignoff() FormatPatchOption {
+	return func(opts *formatPatchOptions) {
+		opts.Signoff = true
+	}
+}
+
+// FormatPatch formats each commit between two references as a patch suitable
+// for submission by email. Each patch is in the mailbox format and can be
+// applied using git am. An empty to reference defaults to HEAD. Patches are
+// returned in the order the commits were made. Raw output is parsed from
+// the following command:
+//
+//	git format-patch --stdout <from>..<to>
+func (c *Client) FormatPatch(from, to string, opts ...FormatPatchOption) ([]string, error) {
+	options := &formatPatchOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	var buf strings.Builder
+	buf.WriteString("git format-patch --stdout")
+
+	if options.Numbered {
+		buf.WriteString(" --numbered")
+	}
+
+	if options.Signoff {
+		buf.WriteString(" --signoff")
+	}
+
+	toRef := strings.TrimSpace(to)
+	if toRef == "" {
+		toRef = "HEAD"
+	}
+	buf.WriteString(fmt.Sprintf(" '%s..%s' --", strings.TrimSpace(from), toRef))
+
+	out, err := c.Exec(buf.String())
+	if err != nil {
+		return nil, err
+	}
+
+	return splitMailbox(out), nil
+}
+
+// The date used by git within the mailbox header of every patch, allowing
+// it to be distinguished from the content of a patch
+const mailboxMagicDate = " Mon Sep 17 00:00:00 2001"
+
+func splitMailbox(out string) []string {
+	var patches []string
+	var patch strings.Builder
+
+	for _, line := range strings.Split(out, "\n") {
+		// Each patch starts with a header line: From <hash> Mon Sep 17 00:00:00 2001
+		if strings.HasPrefix(line, "From ") && strings.HasSuffix(line, mailboxMagicDate) {
+			if patch.Len() > 0 {
+				patches = append(patches, strings.TrimRight(patch.String(), "\n"))
+				patch.Reset()
+			}
+		}
+
+		if patch.Len() > 0 || line != "" {
+			patch.WriteString(line)
+			patch.WriteString("\n")
+		}
+	}
+
+	if patch.Len() > 0 {
+		patches = append(patches, strings.TrimRight(patch.String(), "\n"))
+	}
+
+	return patches
+}
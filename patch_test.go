@@ -0,0 +1,117 @@
+package git_test
+
+import (
+	"strings"
+	"testing"
+
+	git "github.com/purpleclay/gitz"
+	"github.com/purpleclay/gitz/gittest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplyPatch(t *testing.T) {
+	gittest.InitRepository(t, gittest.WithCommittedFiles("belt.txt"))
+	overwriteFile(t, "belt.txt", "grappling hook")
+	patch := gittest.MustExec(t, "git diff")
+	gittest.MustExec(t, "git reset --hard")
+
+	client, _ := git.NewClient()
+	_, err := client.ApplyPatch(patch)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{" M belt.txt"}, gittest.PorcelainStatus(t))
+	assert.Equal(t, patch, gittest.MustExec(t, "git diff"))
+}
+
+func TestApplyPatchWithApplyCheck(t *testing.T) {
+	gittest.InitRepository(t, gittest.WithCommittedFiles("belt.txt"))
+	overwriteFile(t, "belt.txt", "grappling hook")
+	patch := gittest.MustExec(t, "git diff")
+	gittest.MustExec(t, "git reset --hard")
+
+	client, _ := git.NewClient()
+	_, err := client.ApplyPatch(patch, git.WithApplyCheck())
+	require.NoError(t, err)
+
+	assert.Empty(t, gittest.PorcelainStatus(t))
+}
+
+func TestApplyPatchWithApplyReverse(t *testing.T) {
+	gittest.InitRepository(t, gittest.WithCommittedFiles("belt.txt"))
+	overwriteFile(t, "belt.txt", "grappling hook")
+	patch := gittest.MustExec(t, "git diff")
+
+	client, _ := git.NewClient()
+	_, err := client.ApplyPatch(patch, git.WithApplyReverse())
+	require.NoError(t, err)
+
+	assert.Empty(t, gittest.PorcelainStatus(t))
+}
+
+func TestApplyPatchWithApply3Way(t *testing.T) {
+	gittest.InitRepository(t, gittest.WithCommittedFiles("belt.txt"))
+	overwriteFile(t, "belt.txt", "grappling hook")
+	patch := gittest.MustExec(t, "git diff")
+	gittest.MustExec(t, "git reset --hard")
+
+	client, _ := git.NewClient()
+	_, err := client.ApplyPatch(patch, git.WithApply3Way())
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"M  belt.txt"}, gittest.PorcelainStatus(t))
+}
+
+func TestApplyPatchCorruptError(t *testing.T) {
+	gittest.InitRepository(t)
+
+	client, _ := git.NewClient()
+	_, err := client.ApplyPatch("not a patch")
+
+	require.ErrorAs(t, err, &git.ErrGitExecCommand{})
+}
+
+func TestFormatPatch(t *testing.T) {
+	gittest.InitRepository(t)
+	gittest.CreateBranch(t, "feature/gadgets")
+	gittest.Checkout(t, "feature/gadgets")
+	gittest.StagedFile(t, "belt.txt", "grappling hook")
+	gittest.Commit(t, "feat: add utility belt")
+	gittest.StagedFile(t, "belt.txt", "grappling hook\nbatarang")
+	gittest.Commit(t, "feat: add batarang to utility belt")
+
+	client, _ := git.NewClient()
+	patches, err := client.FormatPatch(gittest.DefaultBranch, "feature/gadgets")
+	require.NoError(t, err)
+
+	require.Len(t, patches, 2)
+	assert.True(t, strings.HasPrefix(patches[0], "From "))
+	assert.Contains(t, patches[0], "Subject: [PATCH 1/2] feat: add utility belt")
+	assert.True(t, strings.HasPrefix(patches[1], "From "))
+	assert.Contains(t, patches[1], "Subject: [PATCH 2/2] feat: add batarang to utility belt")
+}
+
+func TestFormatPatchWithFormatPatchNumberedAndSignoff(t *testing.T) {
+	gittest.InitRepository(t)
+	gittest.StagedFile(t, "belt.txt", "grappling hook")
+	gittest.Commit(t, "feat: add utility belt")
+
+	client, _ := git.NewClient()
+	patches, err := client.FormatPatch("HEAD~1", "",
+		git.WithFormatPatchNumbered(), git.WithFormatPatchSignoff())
+	require.NoError(t, err)
+
+	require.Len(t, patches, 1)
+	assert.Contains(t, patches[0], "Subject: [PATCH 1/1] feat: add utility belt")
+	assert.Contains(t, patches[0], "Signed-off-by: "+gittest.DefaultAuthorLog)
+}
+
+func TestFormatPatchNoCommits(t *testing.T) {
+	gittest.InitRepository(t)
+
+	client, _ := git.NewClient()
+	patches, err := client.FormatPatch("HEAD", "")
+
+	require.NoError(t, err)
+	assert.Empty(t, patches)
+}
@@ -14,7 +14,14 @@ type pushOptions struct {
 	All         bool
 	Config      []string
 	Delete      bool
+	DryRun      bool
+	Force       bool
+	ForceLease  bool
+	LeaseRefs   []string
+	NoVerify    bool
 	PushOptions []string
+	Remote      string
+	SetUpstream bool
 	Tags        bool
 	RefSpecs    []string
 }
@@ -44,6 +51,35 @@ func WithDeleteRefSpecs(refs ...string) PushOption {
 	}
 }
 
+// WithDryRun will report what would be pushed to the remote, without
+// sending any updates. The remote remains unchanged
+func WithDryRun() PushOption {
+	return func(opts *pushOptions) {
+		opts.DryRun = true
+	}
+}
+
+// WithPushForce will force the remote to accept all pushed references,
+// overwriting any history that is not an ancestor of the local ref.
+// This can result in commits being lost on the remote
+func WithPushForce() PushOption {
+	return func(opts *pushOptions) {
+		opts.Force = true
+	}
+}
+
+// WithForceWithLease will force the remote to accept all pushed
+// references, but only if each remote reference still points to the
+// value that is expected. Expectations can be provided in the form of
+// <refname>[:<expect>]. Without them, the remote-tracking branch of
+// each reference will be used. A safer alternative to [WithPushForce]
+func WithForceWithLease(refspecs ...string) PushOption {
+	return func(opts *pushOptions) {
+		opts.ForceLease = true
+		opts.LeaseRefs = trim(refspecs...)
+	}
+}
+
 // WithPushConfig allows temporary git config to be set while pushing
 // changes to the remote. Config set using this approach will override
 // any config defined within existing git config files. Config must be
@@ -56,6 +92,15 @@ func WithPushConfig(kv ...string) PushOption {
 	}
 }
 
+// WithPushNoVerify bypasses the pre-push hook during the push. Only
+// hooks local to the current repository (working directory) are
+// bypassed
+func WithPushNoVerify() PushOption {
+	return func(opts *pushOptions) {
+		opts.NoVerify = true
+	}
+}
+
 // WithPushOptions allows any number of aribitrary strings to be pushed
 // to the remote server. All options are transmitted in their received
 // order. A server must have the git config setting receive.advertisePushOptions
@@ -66,6 +111,25 @@ func WithPushOptions(options ...string) PushOption {
 	}
 }
 
+// WithRemote allows the name of the remote to be changed when pushing
+// references. By default, references are pushed to origin. When pushing
+// all branches or tags, git will instead default to the configured push
+// remote of the current branch
+func WithRemote(name string) PushOption {
+	return func(opts *pushOptions) {
+		opts.Remote = strings.TrimSpace(name)
+	}
+}
+
+// WithSetUpstream will configure upstream tracking for every reference
+// that is successfully pushed to the remote. Useful when pushing a newly
+// created local branch for the first time
+func WithSetUpstream() PushOption {
+	return func(opts *pushOptions) {
+		opts.SetUpstream = true
+	}
+}
+
 // WithRefSpecs allows local references to be cherry-picked and
 // pushed back to the remote. A reference (or refspec) can be as
 // simple as a name, where git will automatically resolve any
@@ -104,16 +168,59 @@ func (c *Client) Push(opts ...PushOption) (string, error) {
 	}
 	buf.WriteString(" push")
 
+	if options.DryRun {
+		buf.WriteString(" --dry-run")
+	}
+
+	if options.NoVerify {
+		buf.WriteString(" --no-verify")
+	}
+
+	if options.SetUpstream {
+		buf.WriteString(" --set-upstream")
+	}
+
+	if options.Force {
+		buf.WriteString(" --force")
+	}
+
+	if options.ForceLease {
+		if len(options.LeaseRefs) == 0 {
+			buf.WriteString(" --force-with-lease")
+		}
+
+		for _, ref := range options.LeaseRefs {
+			buf.WriteString(" --force-with-lease=" + ref)
+		}
+	}
+
 	for _, po := range options.PushOptions {
 		buf.WriteString(" --push-option=" + po)
 	}
 
-	if options.All {
-		buf.WriteString(" --all")
-	} else if options.Tags {
-		buf.WriteString(" --tags")
-	} else if len(options.RefSpecs) > 0 {
-		buf.WriteString(" origin ")
+	if options.All || options.Tags {
+		if options.All {
+			buf.WriteString(" --all")
+		} else {
+			buf.WriteString(" --tags")
+		}
+
+		// Without an explicit remote, git will push to the configured
+		// push remote of the current branch
+		if options.Remote != "" {
+			buf.WriteString(" " + options.Remote)
+		}
+
+		return c.Exec(buf.String())
+	}
+
+	remote := options.Remote
+	if remote == "" {
+		remote = "origin"
+	}
+
+	if len(options.RefSpecs) > 0 {
+		buf.WriteString(" " + remote + " ")
 		if options.Delete {
 			buf.WriteString("--delete ")
 		}
@@ -124,7 +231,7 @@ func (c *Client) Push(opts ...PushOption) (string, error) {
 		if err != nil {
 			return out, err
 		}
-		buf.WriteString(fmt.Sprintf(" origin %s", out))
+		buf.WriteString(fmt.Sprintf(" %s %s", remote, out))
 	}
 
 	return c.Exec(buf.String())
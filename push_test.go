@@ -2,6 +2,7 @@ package git_test
 
 import (
 	"fmt"
+	"path/filepath"
 	"testing"
 
 	git "github.com/purpleclay/gitz"
@@ -32,6 +33,21 @@ func TestPushWithPushOptions(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestPushWithPushNoVerify(t *testing.T) {
+	gittest.InitRepository(t, gittest.WithLocalCommits("testing git push without hooks"))
+	failingHook(t, "pre-push")
+
+	client, _ := git.NewClient()
+	_, err := client.Push()
+	require.Error(t, err)
+
+	_, err = client.Push(git.WithPushNoVerify())
+	require.NoError(t, err)
+
+	remoteLog := gittest.RemoteLog(t)
+	assert.Equal(t, "testing git push without hooks", remoteLog[0].Message)
+}
+
 func TestPushResolveBranchError(t *testing.T) {
 	nonWorkingDirectory(t)
 
@@ -111,3 +127,134 @@ func TestPushWithDeleteRefSpecs(t *testing.T) {
 	remoteTags := gittest.RemoteTags(t)
 	assert.ElementsMatch(t, []string{"0.1.0"}, remoteTags)
 }
+
+func TestPushWithPushForce(t *testing.T) {
+	gittest.InitRepository(t, gittest.WithLocalCommits("feat: a change that will be rewritten"))
+
+	client, _ := git.NewClient()
+	_, err := client.Push()
+	require.NoError(t, err)
+
+	_, err = client.Commit("feat: a rewritten change", git.WithAmend(), git.WithAllowEmpty())
+	require.NoError(t, err)
+
+	_, err = client.Push()
+	require.Error(t, err)
+
+	_, err = client.Push(git.WithPushForce())
+	require.NoError(t, err)
+
+	remoteLog := gittest.RemoteLog(t)
+	assert.Equal(t, "feat: a rewritten change", remoteLog[0].Message)
+}
+
+func TestPushWithForceWithLease(t *testing.T) {
+	gittest.InitRepository(t, gittest.WithLocalCommits("feat: a change that will be rewritten"))
+
+	client, _ := git.NewClient()
+	_, err := client.Push()
+	require.NoError(t, err)
+
+	_, err = client.Commit("feat: a rewritten change", git.WithAmend(), git.WithAllowEmpty())
+	require.NoError(t, err)
+
+	_, err = client.Push()
+	require.Error(t, err)
+
+	_, err = client.Push(git.WithForceWithLease())
+	require.NoError(t, err)
+
+	remoteLog := gittest.RemoteLog(t)
+	assert.Equal(t, "feat: a rewritten change", remoteLog[0].Message)
+}
+
+func TestPushWithForceWithLeaseStaleExpectation(t *testing.T) {
+	gittest.InitRepository(t, gittest.WithLocalCommits("feat: a change that will be rewritten"))
+	stale := gittest.LastCommit(t).Hash
+
+	client, _ := git.NewClient()
+	_, err := client.Commit("feat: a change that will be pushed", git.WithAllowEmpty())
+	require.NoError(t, err)
+
+	_, err = client.Push()
+	require.NoError(t, err)
+
+	_, err = client.Commit("feat: a rewritten change", git.WithAmend(), git.WithAllowEmpty())
+	require.NoError(t, err)
+
+	_, err = client.Push(git.WithForceWithLease(gittest.DefaultBranch + ":" + stale))
+	require.Error(t, err)
+}
+
+func TestPushWithAllTagsUsesConfiguredRemote(t *testing.T) {
+	gittest.InitRepository(t, gittest.WithRemoteName("upstream"))
+	gittest.Tag(t, "0.1.0")
+
+	client, _ := git.NewClient()
+	_, err := client.Push(git.WithAllTags())
+	require.NoError(t, err)
+
+	assert.Contains(t, gittest.MustExec(t, "git ls-remote --tags upstream"), "refs/tags/0.1.0")
+}
+
+func TestPushWithRemote(t *testing.T) {
+	log := "(main, origin/main, local-branch) feat: push to a different remote"
+	gittest.InitRepository(t, gittest.WithLog(log))
+	bareRemote(t, "gitlab")
+
+	client, _ := git.NewClient()
+	_, err := client.Push(git.WithRemote("gitlab"), git.WithRefSpecs("local-branch"))
+	require.NoError(t, err)
+
+	assert.Contains(t, gittest.MustExec(t, "git ls-remote --heads gitlab"), "refs/heads/local-branch")
+	assert.NotContains(t, gittest.MustExec(t, "git ls-remote --heads origin"), "refs/heads/local-branch")
+}
+
+func TestPushWithRemoteDeleteRefSpecs(t *testing.T) {
+	log := "(main, origin/main, local-branch) feat: delete from a different remote"
+	gittest.InitRepository(t, gittest.WithLog(log))
+	bareRemote(t, "gitlab")
+	gittest.Exec(t, "git push gitlab local-branch")
+
+	client, _ := git.NewClient()
+	_, err := client.Push(git.WithRemote("gitlab"), git.WithDeleteRefSpecs("local-branch"))
+	require.NoError(t, err)
+
+	assert.Empty(t, gittest.MustExec(t, "git ls-remote --heads gitlab"))
+}
+
+func bareRemote(t *testing.T, name string) string {
+	t.Helper()
+
+	dir := filepath.ToSlash(t.TempDir())
+	gittest.Exec(t, fmt.Sprintf("git init --bare %s", dir))
+	gittest.Exec(t, fmt.Sprintf("git remote add %s %s", name, dir))
+	return dir
+}
+
+func TestPushWithSetUpstream(t *testing.T) {
+	log := "(HEAD -> new-feature, main, origin/main) feat: a brand new feature"
+	gittest.InitRepository(t, gittest.WithLog(log))
+
+	client, _ := git.NewClient()
+	_, err := client.Push(git.WithSetUpstream())
+	require.NoError(t, err)
+
+	cfg, err := client.ConfigL("branch.new-feature.remote", "branch.new-feature.merge")
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"origin"}, cfg["branch.new-feature.remote"])
+	assert.Equal(t, []string{"refs/heads/new-feature"}, cfg["branch.new-feature.merge"])
+}
+
+func TestPushWithDryRun(t *testing.T) {
+	gittest.InitRepository(t, gittest.WithLocalCommits("feat: this should not be pushed"))
+
+	client, _ := git.NewClient()
+	out, err := client.Push(git.WithDryRun())
+	require.NoError(t, err)
+
+	assert.Contains(t, out, fmt.Sprintf("%[1]s -> %[1]s", gittest.DefaultBranch))
+	remoteLog := gittest.RemoteLog(t)
+	assert.NotEqual(t, "feat: this should not be pushed", remoteLog[0].Message)
+}
@@ -0,0 +1,98 @@
+package git
+
+import (
+	"strings"
+)
+
+// RebaseOption provides a way for setting specific options during a rebase
+// operation. Each supported option can customize the way commits are
+// reapplied on top of another base within the current repository
+// (working directory)
+type RebaseOption func(*rebaseOptions)
+
+type rebaseOptions struct {
+	Autostash bool
+	Config    []string
+	Onto      string
+}
+
+// WithAutostash automatically stashes any local changes before the rebase
+// begins, and reapplies them once it completes
+func WithAutostash() RebaseOption {
+	return func(opts *rebaseOptions) {
+		opts.Autostash = true
+	}
+}
+
+// WithOnto provides a new base for the rebase, other than the upstream.
+// Only commits between the upstream and the current branch are reapplied
+// on top of the new base. An empty string will be ignored
+func WithOnto(ref string) RebaseOption {
+	return func(opts *rebaseOptions) {
+		opts.Onto = strings.TrimSpace(ref)
+	}
+}
+
+// WithRebaseConfig allows temporary git config to be set while rebasing.
+// Config set using this approach will override any config defined within
+// existing git config files. Config must be provided as key value pairs,
+// mismatched config will result in an [ErrMissingConfigValue] error. Any
+// invalid paths will result in an [ErrInvalidConfigPath] error
+func WithRebaseConfig(kv ...string) RebaseOption {
+	return func(opts *rebaseOptions) {
+		opts.Config = trim(kv...)
+	}
+}
+
+// Rebase reapplies all commits from the current branch of the repository
+// (working directory) on top of the provided upstream reference. If a conflict
+// occurs, an [ErrGitExecCommand] error is returned, containing the raw git
+// output, which will identify any conflicting files. Once resolved, the rebase
+// can be resumed with [Client.RebaseContinue] or cancelled with
+// [Client.RebaseAbort]
+func (c *Client) Rebase(upstream string, opts ...RebaseOption) (string, error) {
+	options := &rebaseOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	cfg, err := ToInlineConfig(options.Config...)
+	if err != nil {
+		return "", err
+	}
+
+	var buf strings.Builder
+	buf.WriteString("git")
+
+	if len(cfg) > 0 {
+		buf.WriteString(" ")
+		buf.WriteString(strings.Join(cfg, " "))
+	}
+	buf.WriteString(" rebase")
+
+	if options.Autostash {
+		buf.WriteString(" --autostash")
+	}
+
+	if options.Onto != "" {
+		buf.WriteString(" --onto ")
+		buf.WriteString(options.Onto)
+	}
+
+	buf.WriteString(" ")
+	buf.WriteString(strings.TrimSpace(upstream))
+	return c.Exec(buf.String())
+}
+
+// RebaseAbort cancels an in-progress rebase, restoring the current branch
+// to its original state before the rebase began
+func (c *Client) RebaseAbort() (string, error) {
+	return c.Exec("git rebase --abort")
+}
+
+// RebaseContinue resumes an in-progress rebase after all conflicts have
+// been resolved and staged. The existing commit message of any conflicting
+// commit is preserved
+func (c *Client) RebaseContinue() (string, error) {
+	return c.Exec("GIT_EDITOR=true git rebase --continue")
+}
@@ -0,0 +1,84 @@
+package git_test
+
+import (
+	"testing"
+
+	git "github.com/purpleclay/gitz"
+	"github.com/purpleclay/gitz/gittest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRebase(t *testing.T) {
+	gittest.InitRepository(t)
+	gittest.Exec(t, "git checkout -b feature")
+	gittest.StagedFile(t, "feature.txt", "a brand new feature")
+	gittest.Commit(t, "feat: a brand new feature")
+	gittest.Checkout(t, gittest.DefaultBranch)
+	gittest.StagedFile(t, "fix.txt", "a critical fix")
+	gittest.Commit(t, "fix: a critical fix")
+	gittest.Checkout(t, "feature")
+
+	client, _ := git.NewClient()
+	_, err := client.Rebase(gittest.DefaultBranch)
+	require.NoError(t, err)
+
+	log, err := client.Log()
+	require.NoError(t, err)
+
+	require.Len(t, log.Commits, 3)
+	assert.Equal(t, "feat: a brand new feature", log.Commits[0].Message)
+	assert.Equal(t, "fix: a critical fix", log.Commits[1].Message)
+	assert.Equal(t, gittest.InitialCommit, log.Commits[2].Message)
+}
+
+func TestRebaseWithAutostash(t *testing.T) {
+	gittest.InitRepository(t, gittest.WithCommittedFiles("main.go"))
+	gittest.Exec(t, "git checkout -b feature")
+	gittest.CommitEmpty(t, "feat: a brand new feature")
+	gittest.Checkout(t, gittest.DefaultBranch)
+	gittest.CommitEmpty(t, "fix: a critical fix")
+	gittest.Checkout(t, "feature")
+	overwriteFile(t, "main.go", "package main")
+
+	client, _ := git.NewClient()
+	_, err := client.Rebase(gittest.DefaultBranch, git.WithAutostash())
+	require.NoError(t, err)
+
+	assert.ElementsMatch(t, []string{" M main.go"}, gittest.PorcelainStatus(t))
+}
+
+func TestRebaseConflictAbort(t *testing.T) {
+	gittest.InitRepository(t, gittest.WithCommittedFiles("conflict.txt"))
+	hash := conflictingBranches(t, "conflict.txt")
+	gittest.Checkout(t, "feature")
+
+	client, _ := git.NewClient()
+	_, err := client.Rebase(gittest.DefaultBranch)
+	require.ErrorAs(t, err, &git.ErrGitExecCommand{})
+	assert.Contains(t, err.Error(), "CONFLICT")
+
+	_, err = client.RebaseAbort()
+	require.NoError(t, err)
+	assert.Equal(t, hash, gittest.LastCommit(t).Hash)
+}
+
+func TestRebaseConflictContinue(t *testing.T) {
+	gittest.InitRepository(t, gittest.WithCommittedFiles("conflict.txt"))
+	conflictingBranches(t, "conflict.txt")
+	gittest.Checkout(t, "feature")
+
+	client, _ := git.NewClient()
+	_, err := client.Rebase(gittest.DefaultBranch)
+	require.Error(t, err)
+
+	overwriteFile(t, "conflict.txt", "resolved conflict")
+	gittest.StageFile(t, "conflict.txt")
+
+	_, err = client.RebaseContinue()
+	require.NoError(t, err)
+
+	localLog := gittest.LogBetween(t, gittest.DefaultBranch, "feature")
+	require.Len(t, localLog, 1)
+	assert.Equal(t, "fix: change on feature", localLog[0].Message)
+}
@@ -0,0 +1,102 @@
+package git
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ReflogOption provides a way for setting specific options while reading
+// the reflog. Each supported option can customize the entries that are
+// retrieved
+type ReflogOption func(*reflogOptions)
+
+type reflogOptions struct {
+	TakeCount int
+}
+
+// WithReflogTake limits the number of entries that will be retrieved from
+// the reflog. A positive number (greater than zero) is expected
+func WithReflogTake(n int) ReflogOption {
+	return func(opts *reflogOptions) {
+		opts.TakeCount = n
+	}
+}
+
+// ReflogEntry represents a single entry from within the reflog of a
+// reference, recording when its tip was updated
+type ReflogEntry struct {
+	// Hash contains the unique identifier of the commit the reference
+	// pointed to after it was updated
+	Hash string
+
+	// Selector contains the reflog selector associated with the entry,
+	// for example, HEAD@{0}
+	Selector string
+
+	// Action contains the type of operation that updated the reference,
+	// for example, commit, reset or checkout
+	Action string
+
+	// Message contains a description of the update
+	Message string
+}
+
+const reflogFormat = "--format='%H%x1f%gd%x1f%gs'"
+
+// Reflog retrieves the reflog of a reference within the current repository
+// (working directory). The reflog records every update to the tip of a
+// reference, making it possible to recover commits that are no longer
+// reachable. An empty ref will default to HEAD. Entries are returned with
+// the most recent first. Raw output is parsed from the following command:
+//
+//	git reflog show --format='%H%x1f%gd%x1f%gs' <ref>
+func (c *Client) Reflog(ref string, opts ...ReflogOption) ([]ReflogEntry, error) {
+	options := &reflogOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	var buf strings.Builder
+	buf.WriteString("git reflog show " + reflogFormat)
+
+	if options.TakeCount > 0 {
+		buf.WriteString(fmt.Sprintf(" -n%d", options.TakeCount))
+	}
+
+	if r := strings.TrimSpace(ref); r != "" {
+		buf.WriteString(fmt.Sprintf(" '%s'", escapeQuotes(r)))
+	}
+	buf.WriteString(" --")
+
+	out, err := c.Exec(buf.String())
+	if err != nil {
+		return nil, err
+	}
+
+	return parseReflog(out), nil
+}
+
+func parseReflog(out string) []ReflogEntry {
+	if out == "" {
+		return nil
+	}
+
+	var entries []ReflogEntry
+	for _, line := range strings.Split(out, "\n") {
+		fields := strings.SplitN(line, logFieldSeparator, 3)
+		if len(fields) != 3 {
+			continue
+		}
+
+		// A reflog subject is in the format of <action>: <message>
+		action, message, _ := strings.Cut(fields[2], ": ")
+		entries = append(entries, ReflogEntry{
+			Hash:     fields[0],
+			Selector: fields[1],
+			Action:   action,
+			Message:  message,
+		})
+	}
+
+	return entries
+}
@@ -0,0 +1,57 @@
+package git_test
+
+import (
+	"testing"
+
+	git "github.com/purpleclay/gitz"
+	"github.com/purpleclay/gitz/gittest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReflog(t *testing.T) {
+	gittest.InitRepository(t)
+	gittest.CommitEmpty(t, "feat: add utility belt")
+	gittest.CommitEmpty(t, "feat: add grappling hook")
+	gittest.MustExec(t, "git reset --hard HEAD~1")
+
+	client, _ := git.NewClient()
+	entries, err := client.Reflog("HEAD")
+	require.NoError(t, err)
+
+	require.GreaterOrEqual(t, len(entries), 3)
+	assert.Equal(t, "HEAD@{0}", entries[0].Selector)
+	assert.Equal(t, "reset", entries[0].Action)
+	assert.Equal(t, "moving to HEAD~1", entries[0].Message)
+	assert.Equal(t, gittest.LastCommit(t).Hash, entries[0].Hash)
+
+	assert.Equal(t, "HEAD@{1}", entries[1].Selector)
+	assert.Equal(t, "commit", entries[1].Action)
+	assert.Equal(t, "feat: add grappling hook", entries[1].Message)
+
+	assert.Equal(t, "commit", entries[2].Action)
+	assert.Equal(t, "feat: add utility belt", entries[2].Message)
+}
+
+func TestReflogWithReflogTake(t *testing.T) {
+	gittest.InitRepository(t, gittest.WithLocalCommits("feat: add utility belt", "feat: add grappling hook"))
+
+	client, _ := git.NewClient()
+	entries, err := client.Reflog("", git.WithReflogTake(1))
+	require.NoError(t, err)
+
+	require.Len(t, entries, 1)
+	assert.Equal(t, "feat: add grappling hook", entries[0].Message)
+}
+
+func TestReflogQuotedRef(t *testing.T) {
+	gittest.InitRepository(t)
+	gittest.Exec(t, `git branch --create-reflog "it's"`)
+
+	client, _ := git.NewClient()
+	entries, err := client.Reflog("it's")
+	require.NoError(t, err)
+
+	require.Len(t, entries, 1)
+	assert.Equal(t, "it's@{0}", entries[0].Selector)
+}
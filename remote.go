@@ -0,0 +1,88 @@
+package git
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+)
+
+// Remote contains details about a named remote tracked by a repository
+type Remote struct {
+	// Name of the remote
+	Name string
+
+	// FetchURL contains the URL used when fetching from the remote
+	FetchURL string
+
+	// PushURL contains the URL used when pushing to the remote. This will
+	// match the FetchURL, unless a different push URL has been set
+	PushURL string
+}
+
+// AddRemote adds a new named remote to the current repository (working
+// directory), which will be tracked at the provided URL
+func (c *Client) AddRemote(name, url string) (string, error) {
+	return c.Exec(fmt.Sprintf("git remote add %s '%s'",
+		strings.TrimSpace(name), escapeQuotes(strings.TrimSpace(url))))
+}
+
+// RemoveRemote removes a named remote from the current repository (working
+// directory). All remote-tracking branches and config associated with the
+// remote are also removed
+func (c *Client) RemoveRemote(name string) (string, error) {
+	return c.Exec("git remote remove " + strings.TrimSpace(name))
+}
+
+// RenameRemote renames an existing remote within the current repository
+// (working directory). All remote-tracking branches and config associated
+// with the remote are updated to reflect its new name
+func (c *Client) RenameRemote(oldName, newName string) (string, error) {
+	return c.Exec(fmt.Sprintf("git remote rename %s %s",
+		strings.TrimSpace(oldName), strings.TrimSpace(newName)))
+}
+
+// SetRemoteURL changes the URL of an existing named remote within the
+// current repository (working directory)
+func (c *Client) SetRemoteURL(name, url string) (string, error) {
+	return c.Exec(fmt.Sprintf("git remote set-url %s '%s'",
+		strings.TrimSpace(name), escapeQuotes(strings.TrimSpace(url))))
+}
+
+// Remotes retrieves details of all remotes tracked by the current
+// repository (working directory), keyed by their name. Both the fetch
+// and push URLs of each remote are captured, as they can differ
+func (c *Client) Remotes() (map[string]Remote, error) {
+	out, err := c.Exec("git remote -v")
+	if err != nil {
+		return nil, err
+	}
+
+	return parseRemotes(out), nil
+}
+
+func parseRemotes(out string) map[string]Remote {
+	remotes := map[string]Remote{}
+	if out == "" {
+		return remotes
+	}
+
+	for _, line := range strings.Split(out, "\n") {
+		name, url, found := strings.Cut(line, "\t")
+		if !found {
+			continue
+		}
+
+		remote := remotes[name]
+		remote.Name = name
+
+		if fetchURL, isFetch := strings.CutSuffix(url, " (fetch)"); isFetch {
+			remote.FetchURL = filepath.ToSlash(fetchURL)
+		} else if pushURL, isPush := strings.CutSuffix(url, " (push)"); isPush {
+			remote.PushURL = filepath.ToSlash(pushURL)
+		}
+
+		remotes[name] = remote
+	}
+
+	return remotes
+}
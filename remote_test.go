@@ -0,0 +1,102 @@
+package git_test
+
+import (
+	"testing"
+
+	git "github.com/purpleclay/gitz"
+	"github.com/purpleclay/gitz/gittest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddRemote(t *testing.T) {
+	gittest.InitRepository(t)
+
+	client, _ := git.NewClient()
+	_, err := client.AddRemote("upstream", "git@github.com:purpleclay/upstream.git")
+	require.NoError(t, err)
+
+	repo, err := client.Repository()
+	require.NoError(t, err)
+
+	require.Len(t, repo.Remotes, 2)
+	assert.Equal(t, "git@github.com:purpleclay/upstream.git", repo.Remotes["upstream"])
+}
+
+func TestAddRemoteAlreadyExistsError(t *testing.T) {
+	gittest.InitRepository(t)
+
+	client, _ := git.NewClient()
+	_, err := client.AddRemote(gittest.DefaultOrigin, "git@github.com:purpleclay/upstream.git")
+
+	require.ErrorAs(t, err, &git.ErrGitExecCommand{})
+}
+
+func TestRemoveRemote(t *testing.T) {
+	gittest.InitRepository(t)
+	gittest.Exec(t, "git remote add upstream git@github.com:purpleclay/upstream.git")
+
+	client, _ := git.NewClient()
+	_, err := client.RemoveRemote("upstream")
+	require.NoError(t, err)
+
+	repo, err := client.Repository()
+	require.NoError(t, err)
+
+	require.Len(t, repo.Remotes, 1)
+	assert.NotContains(t, repo.Remotes, "upstream")
+}
+
+func TestRenameRemoteAndSetRemoteURL(t *testing.T) {
+	gittest.InitRepository(t)
+
+	client, _ := git.NewClient()
+	_, err := client.AddRemote("upstream", "git@github.com:purpleclay/upstream.git")
+	require.NoError(t, err)
+
+	_, err = client.RenameRemote("upstream", "gitlab")
+	require.NoError(t, err)
+
+	_, err = client.SetRemoteURL("gitlab", "git@gitlab.com:purpleclay/upstream.git")
+	require.NoError(t, err)
+
+	repo, err := client.Repository()
+	require.NoError(t, err)
+
+	require.Len(t, repo.Remotes, 2)
+	assert.NotContains(t, repo.Remotes, "upstream")
+	assert.Equal(t, "git@gitlab.com:purpleclay/upstream.git", repo.Remotes["gitlab"])
+}
+
+func TestRemotes(t *testing.T) {
+	gittest.InitRepository(t)
+	gittest.Exec(t, "git remote add upstream git@github.com:purpleclay/upstream.git")
+	gittest.Exec(t, "git remote set-url --push upstream git@github.com:purpleclay/fork.git")
+
+	client, _ := git.NewClient()
+	remotes, err := client.Remotes()
+	require.NoError(t, err)
+
+	require.Len(t, remotes, 2)
+	assert.Equal(t, git.Remote{
+		Name:     gittest.DefaultOrigin,
+		FetchURL: gittest.Remote(t),
+		PushURL:  gittest.Remote(t),
+	}, remotes[gittest.DefaultOrigin])
+	assert.Equal(t, git.Remote{
+		Name:     "upstream",
+		FetchURL: "git@github.com:purpleclay/upstream.git",
+		PushURL:  "git@github.com:purpleclay/fork.git",
+	}, remotes["upstream"])
+}
+
+func TestRemotesNone(t *testing.T) {
+	gittest.InitRepository(t)
+	gittest.Exec(t, "git remote remove "+gittest.DefaultOrigin)
+
+	client, _ := git.NewClient()
+	remotes, err := client.Remotes()
+
+	require.NoError(t, err)
+	assert.Empty(t, remotes)
+}
@@ -5,6 +5,85 @@ import (
 	"strings"
 )
 
+// RestoreOption provides a way for setting specific options during a restore
+// operation. Each supported option can customize the way files are restored
+// within the current repository (working directory)
+type RestoreOption func(*restoreOptions)
+
+type restoreOptions struct {
+	Source   string
+	Staged   bool
+	Worktree bool
+}
+
+// WithRestoreSource restores files using their contents from a specific
+// reference, such as a commit, tag or branch. By default, files within
+// the working tree are restored from the index, and files within the
+// index are restored from HEAD. An empty string will be ignored
+func WithRestoreSource(ref string) RestoreOption {
+	return func(opts *restoreOptions) {
+		opts.Source = strings.TrimSpace(ref)
+	}
+}
+
+// WithRestoreStaged restores files within the index, effectively
+// unstaging them. When combined with [WithRestoreWorktree], both the
+// index and working tree are restored
+func WithRestoreStaged() RestoreOption {
+	return func(opts *restoreOptions) {
+		opts.Staged = true
+	}
+}
+
+// WithRestoreWorktree restores files within the working tree. This is the
+// default behavior, unless [WithRestoreStaged] is provided
+func WithRestoreWorktree() RestoreOption {
+	return func(opts *restoreOptions) {
+		opts.Worktree = true
+	}
+}
+
+// Restore a given set of paths back to a previous known state within the
+// current repository (working directory). By default, files within the
+// working tree are restored from the index. Paths to files and folders
+// are relative to the root of the repository. All leading and trailing
+// whitespace will be trimmed from the file paths, allowing empty paths to
+// be ignored
+func (c *Client) Restore(paths []string, opts ...RestoreOption) error {
+	paths = trim(paths...)
+	if len(paths) == 0 {
+		return nil
+	}
+
+	options := &restoreOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	var buf strings.Builder
+	buf.WriteString("git restore")
+
+	if options.Source != "" {
+		buf.WriteString(fmt.Sprintf(" --source='%s'", escapeQuotes(options.Source)))
+	}
+
+	if options.Staged {
+		buf.WriteString(" --staged")
+	}
+
+	if options.Worktree {
+		buf.WriteString(" --worktree")
+	}
+
+	buf.WriteString(" --")
+	for _, path := range paths {
+		buf.WriteString(fmt.Sprintf(" '%s'", escapeQuotes(path)))
+	}
+
+	_, err := c.Exec(buf.String())
+	return err
+}
+
 // RestoreUsing will restore a given set of files back to their previous
 // known state within the current repository (working directory). By
 // inspecting each files [FileStatus], the correct decision can be made
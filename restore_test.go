@@ -60,3 +60,57 @@ func TestRestoreUsingForRenamedFiles(t *testing.T) {
 	statuses := gittest.PorcelainStatus(t)
 	assert.Empty(t, statuses)
 }
+
+func TestRestoreWithRestoreStaged(t *testing.T) {
+	gittest.InitRepository(t, gittest.WithStagedFiles("main.go", "doc.go"))
+
+	client, _ := git.NewClient()
+	err := client.Restore([]string{"main.go"}, git.WithRestoreStaged())
+	require.NoError(t, err)
+
+	staged, err := client.Staged()
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"doc.go"}, staged)
+}
+
+func TestRestore(t *testing.T) {
+	gittest.InitRepository(t, gittest.WithCommittedFiles("main.go", "doc.go"))
+	overwriteFile(t, "main.go", "package main")
+	overwriteFile(t, "doc.go", "package main")
+
+	client, _ := git.NewClient()
+	err := client.Restore([]string{"main.go"})
+	require.NoError(t, err)
+
+	assert.ElementsMatch(t, []string{" M doc.go"}, gittest.PorcelainStatus(t))
+}
+
+func TestRestoreWithRestoreSource(t *testing.T) {
+	gittest.InitRepository(t, gittest.WithCommittedFiles("main.go"))
+	overwriteFile(t, "main.go", "package main")
+	gittest.StageFile(t, "main.go")
+	gittest.Commit(t, "feat: updated main.go")
+
+	client, _ := git.NewClient()
+	err := client.Restore([]string{"main.go"},
+		git.WithRestoreSource("HEAD~1"),
+		git.WithRestoreStaged(),
+		git.WithRestoreWorktree())
+	require.NoError(t, err)
+
+	assert.ElementsMatch(t, []string{"M  main.go"}, gittest.PorcelainStatus(t))
+	assert.Equal(t, gittest.FileContent, gittest.MustExec(t, "cat main.go"))
+}
+
+func TestRestoreQuotedPath(t *testing.T) {
+	gittest.InitRepository(t, gittest.WithFiles("it's quoted.go"))
+	gittest.Exec(t, `git add "it's quoted.go"`)
+	gittest.Exec(t, `git commit -m "feat: add a quoted file"`)
+	overwriteFile(t, "it's quoted.go", "package main")
+
+	client, _ := git.NewClient()
+	err := client.Restore([]string{"it's quoted.go"})
+	require.NoError(t, err)
+
+	assert.Empty(t, gittest.PorcelainStatus(t))
+}
@@ -0,0 +1,65 @@
+package git
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// CountOption provides a way for setting specific options when counting
+// commits. Each supported option can customize the range of commits
+// that are included within the count
+type CountOption func(*countOptions)
+
+type countOptions struct {
+	Between bool
+	From    string
+	To      string
+}
+
+// WithCountBetween counts the commits reachable from the to reference,
+// excluding any that are reachable from the from reference, for example,
+// the commits between two tags. When provided, the reference passed to
+// [Client.CountCommits] is ignored. All leading and trailing whitespace
+// are trimmed from the references. An empty from reference is rejected,
+// while an empty to reference defaults to HEAD
+func WithCountBetween(from, to string) CountOption {
+	return func(opts *countOptions) {
+		opts.Between = true
+		opts.From = strings.TrimSpace(from)
+		opts.To = strings.TrimSpace(to)
+	}
+}
+
+// CountCommits returns the number of commits reachable from the given
+// reference. Typically a reference can be either a commit hash, branch
+// name or tag. An empty reference defaults to HEAD. The count is
+// retrieved using the following command:
+//
+//	git rev-list --count <ref>
+func (c *Client) CountCommits(ref string, opts ...CountOption) (int, error) {
+	options := &countOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	rev := strings.TrimSpace(ref)
+	if rev == "" {
+		rev = "HEAD"
+	}
+
+	if options.Between {
+		if options.From == "" {
+			return 0, errors.New("a from reference is required when counting commits between references")
+		}
+		rev = fmt.Sprintf("%s..%s", options.From, options.To)
+	}
+
+	out, err := c.Exec(fmt.Sprintf("git rev-list --count '%s' --", escapeQuotes(rev)))
+	if err != nil {
+		return 0, err
+	}
+
+	return strconv.Atoi(strings.TrimSpace(out))
+}
@@ -0,0 +1,68 @@
+package git_test
+
+import (
+	"testing"
+
+	git "github.com/purpleclay/gitz"
+	"github.com/purpleclay/gitz/gittest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCountCommits(t *testing.T) {
+	log := `(main, origin/main) feat: add batarang to utility belt
+(tag: 0.2.0) fix: grappling hook range
+feat: add grappling hook
+(tag: 0.1.0) feat: add utility belt`
+	gittest.InitRepository(t, gittest.WithLog(log))
+
+	client, _ := git.NewClient()
+	count, err := client.CountCommits("HEAD")
+
+	require.NoError(t, err)
+	assert.Equal(t, 5, count)
+}
+
+func TestCountCommitsWithCountBetween(t *testing.T) {
+	log := `(main, origin/main) feat: add batarang to utility belt
+(tag: 0.2.0) fix: grappling hook range
+feat: add grappling hook
+(tag: 0.1.0) feat: add utility belt`
+	gittest.InitRepository(t, gittest.WithLog(log))
+
+	client, _ := git.NewClient()
+	count, err := client.CountCommits("", git.WithCountBetween("0.1.0", "0.2.0"))
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+}
+
+func TestCountCommitsUnknownRefError(t *testing.T) {
+	gittest.InitRepository(t)
+
+	client, _ := git.NewClient()
+	_, err := client.CountCommits("unknown")
+
+	require.ErrorAs(t, err, &git.ErrGitExecCommand{})
+}
+
+func TestCountCommitsWithCountBetweenDefaultsToHead(t *testing.T) {
+	log := `(main, origin/main) feat: add batarang to utility belt
+(tag: 0.1.0) feat: add utility belt`
+	gittest.InitRepository(t, gittest.WithLog(log))
+
+	client, _ := git.NewClient()
+	count, err := client.CountCommits("", git.WithCountBetween("0.1.0", ""))
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+}
+
+func TestCountCommitsWithCountBetweenEmptyFromError(t *testing.T) {
+	gittest.InitRepository(t)
+
+	client, _ := git.NewClient()
+	_, err := client.CountCommits("", git.WithCountBetween(" ", "HEAD"))
+
+	require.EqualError(t, err, "a from reference is required when counting commits between references")
+}
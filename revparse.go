@@ -0,0 +1,65 @@
+package git
+
+import (
+	"fmt"
+	"strings"
+)
+
+// RevParseOption provides a way for setting specific options during a
+// rev-parse operation. Each supported option can customize the way a
+// revision is resolved
+type RevParseOption func(*revParseOptions)
+
+type revParseOptions struct {
+	AbbrevRef   bool
+	Short       bool
+	ShortLength int
+}
+
+// WithAbbrevRef resolves the revision to a short and unambiguous reference
+// name, rather than a hash. For example, HEAD will be resolved to the name
+// of the current branch
+func WithAbbrevRef() RevParseOption {
+	return func(opts *revParseOptions) {
+		opts.AbbrevRef = true
+	}
+}
+
+// WithShortHash resolves the revision to an abbreviated hash of at least
+// the provided length. The hash will be longer if needed to remain unique.
+// Any length less than one will use the default abbreviation length
+// configured within git
+func WithShortHash(n int) RevParseOption {
+	return func(opts *revParseOptions) {
+		opts.Short = true
+		opts.ShortLength = n
+	}
+}
+
+// RevParse resolves a revision into its hash. Any revision supported by
+// git can be resolved, including branches, tags and relative references
+// such as HEAD~2 or main@{upstream}. An error is returned if the revision
+// cannot be resolved
+func (c *Client) RevParse(rev string, opts ...RevParseOption) (string, error) {
+	options := &revParseOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	var buf strings.Builder
+	buf.WriteString("git rev-parse --verify")
+
+	if options.AbbrevRef {
+		buf.WriteString(" --abbrev-ref")
+	}
+
+	if options.Short {
+		buf.WriteString(" --short")
+		if options.ShortLength > 0 {
+			buf.WriteString(fmt.Sprintf("=%d", options.ShortLength))
+		}
+	}
+
+	buf.WriteString(fmt.Sprintf(" '%s'", strings.TrimSpace(rev)))
+	return c.Exec(buf.String())
+}
@@ -0,0 +1,66 @@
+package git_test
+
+import (
+	"testing"
+
+	git "github.com/purpleclay/gitz"
+	"github.com/purpleclay/gitz/gittest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRevParse(t *testing.T) {
+	gittest.InitRepository(t, gittest.WithLocalCommits("feat: resolve this commit"))
+
+	client, _ := git.NewClient()
+	hash, err := client.RevParse("HEAD")
+
+	require.NoError(t, err)
+	assert.Equal(t, gittest.LastCommit(t).Hash, hash)
+}
+
+func TestRevParseRelativeRef(t *testing.T) {
+	log := `feat: the third commit
+feat: the second commit
+feat: the first commit`
+	gittest.InitRepository(t, gittest.WithLog(log))
+
+	client, _ := git.NewClient()
+	hash, err := client.RevParse("HEAD~2")
+
+	require.NoError(t, err)
+	assert.Equal(t, gittest.Log(t)[2].Hash, hash)
+}
+
+func TestRevParseWithShortHash(t *testing.T) {
+	gittest.InitRepository(t, gittest.WithLocalCommits("feat: resolve this commit"))
+
+	client, _ := git.NewClient()
+	hash, err := client.RevParse("HEAD", git.WithShortHash(7))
+	require.NoError(t, err)
+	assert.Equal(t, gittest.LastCommit(t).AbbrevHash, hash)
+
+	hash, err = client.RevParse("HEAD", git.WithShortHash(12))
+	require.NoError(t, err)
+	assert.Equal(t, gittest.LastCommit(t).Hash[:12], hash)
+}
+
+func TestRevParseWithAbbrevRef(t *testing.T) {
+	log := "(HEAD -> feature, main, origin/main) feat: resolve the current branch"
+	gittest.InitRepository(t, gittest.WithLog(log))
+
+	client, _ := git.NewClient()
+	ref, err := client.RevParse("HEAD", git.WithAbbrevRef())
+
+	require.NoError(t, err)
+	assert.Equal(t, "feature", ref)
+}
+
+func TestRevParseUnknownRevisionError(t *testing.T) {
+	gittest.InitRepository(t)
+
+	client, _ := git.NewClient()
+	_, err := client.RevParse("does-not-exist")
+
+	require.ErrorAs(t, err, &git.ErrGitExecCommand{})
+}
@@ -0,0 +1,67 @@
+package git
+
+import (
+	"fmt"
+	"strings"
+)
+
+// RmOption provides a way for setting specific options while removing
+// tracked files. Each supported option can customize how files are
+// removed from the current repository (working directory)
+type RmOption func(*rmOptions)
+
+type rmOptions struct {
+	Cached    bool
+	Recursive bool
+}
+
+// WithCached only removes the files from the index, keeping them within
+// the working tree. Once committed, the files will no longer be tracked
+func WithCached() RmOption {
+	return func(opts *rmOptions) {
+		opts.Cached = true
+	}
+}
+
+// WithRecursive allows directories to be removed, by recursively removing
+// all of the files they contain
+func WithRecursive() RmOption {
+	return func(opts *rmOptions) {
+		opts.Recursive = true
+	}
+}
+
+// Rm removes tracked files from both the index and working tree of the
+// current repository (working directory). The removal is staged, ready
+// to be committed. Paths are relative to the current working directory.
+// All leading and trailing whitespace will be trimmed from the paths,
+// allowing empty paths to be ignored
+func (c *Client) Rm(paths []string, opts ...RmOption) (string, error) {
+	paths = trim(paths...)
+	if len(paths) == 0 {
+		return "", nil
+	}
+
+	options := &rmOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	var buf strings.Builder
+	buf.WriteString("git rm")
+
+	if options.Cached {
+		buf.WriteString(" --cached")
+	}
+
+	if options.Recursive {
+		buf.WriteString(" -r")
+	}
+
+	buf.WriteString(" --")
+	for _, path := range paths {
+		buf.WriteString(fmt.Sprintf(" '%s'", escapeQuotes(path)))
+	}
+
+	return c.Exec(buf.String())
+}
@@ -0,0 +1,52 @@
+package git_test
+
+import (
+	"testing"
+
+	git "github.com/purpleclay/gitz"
+	"github.com/purpleclay/gitz/gittest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRm(t *testing.T) {
+	gittest.InitRepository(t, gittest.WithCommittedFiles("main.go", "cmd.go"))
+
+	client, _ := git.NewClient()
+	_, err := client.Rm([]string{"main.go"})
+	require.NoError(t, err)
+
+	assert.NoFileExists(t, "main.go")
+	assert.ElementsMatch(t, []string{"D  main.go"}, gittest.PorcelainStatus(t))
+}
+
+func TestRmWithCached(t *testing.T) {
+	gittest.InitRepository(t, gittest.WithCommittedFiles("main.go"))
+
+	client, _ := git.NewClient()
+	_, err := client.Rm([]string{"main.go"}, git.WithCached())
+	require.NoError(t, err)
+
+	assert.FileExists(t, "main.go")
+	assert.ElementsMatch(t, []string{"D  main.go", "?? main.go"}, gittest.PorcelainStatus(t))
+}
+
+func TestRmWithRecursive(t *testing.T) {
+	gittest.InitRepository(t, gittest.WithCommittedFiles("internal/parser.go", "internal/lexer.go"))
+
+	client, _ := git.NewClient()
+	_, err := client.Rm([]string{"internal"}, git.WithRecursive())
+	require.NoError(t, err)
+
+	assert.NoDirExists(t, "internal")
+	assert.ElementsMatch(t, []string{"D  internal/parser.go", "D  internal/lexer.go"}, gittest.PorcelainStatus(t))
+}
+
+func TestRmDirectoryWithoutRecursiveError(t *testing.T) {
+	gittest.InitRepository(t, gittest.WithCommittedFiles("internal/parser.go"))
+
+	client, _ := git.NewClient()
+	_, err := client.Rm([]string{"internal"})
+
+	require.ErrorAs(t, err, &git.ErrGitExecCommand{})
+}
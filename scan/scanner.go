@@ -60,3 +60,26 @@ func DiffLines() func(data []byte, atEOF bool) (advance int, token []byte, err e
 		return 0, nil, nil
 	}
 }
+
+// NullTerminatedLines is a split function for a [bufio.Scanner] that splits text
+// into multiple blocks, each terminated by a NUL character. The NUL character
+// is discarded, but unlike other split functions, any whitespace is retained,
+// ensuring text containing newlines survives intact. A final block of text
+// that is not terminated by a NUL character is also returned
+func NullTerminatedLines() func(data []byte, atEOF bool) (advance int, token []byte, err error) {
+	return func(data []byte, atEOF bool) (advance int, token []byte, err error) {
+		if atEOF && len(data) == 0 {
+			return 0, nil, nil
+		}
+
+		if i := bytes.IndexByte(data, 0); i >= 0 {
+			return i + 1, data[:i], nil
+		}
+
+		if atEOF {
+			return len(data), data, nil
+		}
+
+		return 0, nil, nil
+	}
+}
@@ -141,3 +141,16 @@ index 906a132..2e6954c 100644
         AllowEmpty    bool
         Config        []string`, lines[1])
 }
+
+func TestNullTerminatedLines(t *testing.T) {
+	text := "user.name\njoker\x00alias.multi\n!f() {\n  echo hello\n}; f\x00core.bare\nfalse"
+
+	scanner := bufio.NewScanner(strings.NewReader(text))
+	scanner.Split(scan.NullTerminatedLines())
+
+	lines := readUntilEOF(t, scanner)
+	require.Len(t, lines, 3)
+	assert.Equal(t, "user.name\njoker", lines[0])
+	assert.Equal(t, "alias.multi\n!f() {\n  echo hello\n}; f", lines[1])
+	assert.Equal(t, "core.bare\nfalse", lines[2])
+}
@@ -0,0 +1,84 @@
+package git
+
+import (
+	"strings"
+)
+
+// ShowRefOption provides a way for setting specific options when listing
+// references within the local repository. Each supported option can
+// customize the references that are retrieved
+type ShowRefOption func(*showRefOptions)
+
+type showRefOptions struct {
+	Heads bool
+	Tags  bool
+}
+
+// WithShowRefHeads limits the retrieved references to branches only
+func WithShowRefHeads() ShowRefOption {
+	return func(opts *showRefOptions) {
+		opts.Heads = true
+	}
+}
+
+// WithShowRefTags limits the retrieved references to tags only
+func WithShowRefTags() ShowRefOption {
+	return func(opts *showRefOptions) {
+		opts.Tags = true
+	}
+}
+
+// Ref represents a reference within the local repository
+type Ref struct {
+	// Hash of the object the reference points to
+	Hash string
+
+	// Name contains the fully qualified name of the reference,
+	// for example refs/heads/main
+	Name string
+}
+
+// ShowRef lists all references within the local repository, including
+// branches, remote-tracking branches and tags, along with the hash of the
+// object they point to. Raw output is parsed from the following command:
+//
+//	git show-ref
+func (c *Client) ShowRef(opts ...ShowRefOption) ([]Ref, error) {
+	options := &showRefOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	var buf strings.Builder
+	buf.WriteString("git show-ref")
+
+	if options.Heads {
+		buf.WriteString(" --heads")
+	}
+
+	if options.Tags {
+		buf.WriteString(" --tags")
+	}
+
+	out, err := c.Exec(buf.String())
+	if err != nil {
+		// Git exits with an exit code of 1 if no references exist
+		if isExitCode(err, 1) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return parseRefs(out), nil
+}
+
+func parseRefs(out string) []Ref {
+	var refs []Ref
+	for _, line := range strings.Split(out, "\n") {
+		if hash, name, found := strings.Cut(line, " "); found {
+			refs = append(refs, Ref{Hash: hash, Name: name})
+		}
+	}
+
+	return refs
+}
@@ -0,0 +1,57 @@
+package git_test
+
+import (
+	"testing"
+
+	git "github.com/purpleclay/gitz"
+	"github.com/purpleclay/gitz/gittest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShowRef(t *testing.T) {
+	gittest.InitRepository(t)
+	gittest.CommitEmpty(t, "feat: add grappling hook")
+	gittest.CreateBranch(t, "feature/gadgets")
+	gittest.Tag(t, "0.1.0")
+	hash := gittest.LastCommit(t).Hash
+
+	client, _ := git.NewClient()
+	refs, err := client.ShowRef()
+	require.NoError(t, err)
+
+	assert.Contains(t, refs, git.Ref{Hash: hash, Name: "refs/heads/feature/gadgets"})
+	assert.Contains(t, refs, git.Ref{Hash: hash, Name: "refs/tags/0.1.0"})
+}
+
+func TestShowRefWithShowRefHeads(t *testing.T) {
+	gittest.InitRepository(t, gittest.WithTags("0.1.0"))
+
+	client, _ := git.NewClient()
+	refs, err := client.ShowRef(git.WithShowRefHeads())
+	require.NoError(t, err)
+
+	require.Len(t, refs, 1)
+	assert.Equal(t, "refs/heads/"+gittest.DefaultBranch, refs[0].Name)
+}
+
+func TestShowRefWithShowRefTags(t *testing.T) {
+	gittest.InitRepository(t, gittest.WithTags("0.1.0"))
+
+	client, _ := git.NewClient()
+	refs, err := client.ShowRef(git.WithShowRefTags())
+	require.NoError(t, err)
+
+	require.Len(t, refs, 1)
+	assert.Equal(t, "refs/tags/0.1.0", refs[0].Name)
+}
+
+func TestShowRefNoMatches(t *testing.T) {
+	gittest.InitRepository(t)
+
+	client, _ := git.NewClient()
+	refs, err := client.ShowRef(git.WithShowRefTags())
+
+	require.NoError(t, err)
+	assert.Empty(t, refs)
+}
@@ -0,0 +1,156 @@
+package git
+
+import (
+	"bufio"
+	"fmt"
+	"strings"
+)
+
+// StashOption provides a way for setting specific options during a stash
+// operation. Each supported option can customize the way local changes are
+// stashed within the current repository (working directory)
+type StashOption func(*stashOptions)
+
+type stashOptions struct {
+	IncludeUntracked bool
+	KeepIndex        bool
+	Message          string
+}
+
+// WithStashIncludeUntracked ensures any untracked files are also stashed
+// and then removed from the working tree
+func WithStashIncludeUntracked() StashOption {
+	return func(opts *stashOptions) {
+		opts.IncludeUntracked = true
+	}
+}
+
+// WithStashKeepIndex ensures all changes already staged within the index
+// are left intact after the stash
+func WithStashKeepIndex() StashOption {
+	return func(opts *stashOptions) {
+		opts.KeepIndex = true
+	}
+}
+
+// WithStashMessage assigns a description to the stash entry. Any leading
+// and trailing whitespace will automatically be trimmed from the message.
+// This allows empty messages to be ignored
+func WithStashMessage(msg string) StashOption {
+	return func(opts *stashOptions) {
+		opts.Message = strings.TrimSpace(msg)
+	}
+}
+
+// StashEntry represents a single entry within the stash of a repository
+type StashEntry struct {
+	// Ref contains the reference to the stash entry, which can be used to
+	// apply, pop or drop the entry:
+	//
+	//	stash@{0}
+	Ref string
+
+	// Branch contains the name of the branch the stash entry was
+	// created from
+	Branch string
+
+	// Message contains the description associated with the stash entry
+	Message string
+}
+
+// Stash records all local changes within the current repository (working
+// directory) as a new stash entry, reverting the working tree back to the
+// HEAD (or latest commit)
+func (c *Client) Stash(opts ...StashOption) (string, error) {
+	options := &stashOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	var buf strings.Builder
+	buf.WriteString("git stash push")
+
+	if options.IncludeUntracked {
+		buf.WriteString(" --include-untracked")
+	}
+
+	if options.KeepIndex {
+		buf.WriteString(" --keep-index")
+	}
+
+	if options.Message != "" {
+		buf.WriteString(fmt.Sprintf(" -m '%s'", escapeQuotes(options.Message)))
+	}
+
+	return c.Exec(buf.String())
+}
+
+// StashPop applies the changes recorded within a stash entry to the
+// current repository (working directory) and removes it from the stash.
+// If an empty reference is provided, the latest stash entry is used
+func (c *Client) StashPop(ref string) (string, error) {
+	return c.stashRefCmd("pop", ref)
+}
+
+// StashApply applies the changes recorded within a stash entry to the
+// current repository (working directory), without removing it from the
+// stash. If an empty reference is provided, the latest stash entry is used
+func (c *Client) StashApply(ref string) (string, error) {
+	return c.stashRefCmd("apply", ref)
+}
+
+// StashDrop removes a stash entry from the stash. If an empty reference
+// is provided, the latest stash entry is removed
+func (c *Client) StashDrop(ref string) (string, error) {
+	return c.stashRefCmd("drop", ref)
+}
+
+func (c *Client) stashRefCmd(cmd, ref string) (string, error) {
+	var buf strings.Builder
+	buf.WriteString("git stash ")
+	buf.WriteString(cmd)
+
+	if ref = strings.TrimSpace(ref); ref != "" {
+		buf.WriteString(fmt.Sprintf(" '%s'", escapeQuotes(ref)))
+	}
+
+	return c.Exec(buf.String())
+}
+
+// StashList retrieves all entries from the stash of the current repository
+// (working directory), ordered by the most recent entry first
+func (c *Client) StashList() ([]StashEntry, error) {
+	out, err := c.Exec("git stash list --format='%gd %gs'")
+	if err != nil {
+		return nil, err
+	}
+
+	return parseStashList(out), nil
+}
+
+func parseStashList(list string) []StashEntry {
+	var entries []StashEntry
+
+	scanner := bufio.NewScanner(strings.NewReader(list))
+	scanner.Split(bufio.ScanLines)
+
+	for scanner.Scan() {
+		// Expected format: stash@{0} (WIP on|On) <branch>: <message>
+		ref, subject, found := strings.Cut(scanner.Text(), " ")
+		if !found {
+			continue
+		}
+
+		subject = strings.TrimPrefix(subject, "WIP on ")
+		subject = strings.TrimPrefix(subject, "On ")
+		branch, msg, _ := strings.Cut(subject, ": ")
+
+		entries = append(entries, StashEntry{
+			Ref:     ref,
+			Branch:  branch,
+			Message: msg,
+		})
+	}
+
+	return entries
+}
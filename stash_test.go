@@ -0,0 +1,114 @@
+package git_test
+
+import (
+	"testing"
+
+	git "github.com/purpleclay/gitz"
+	"github.com/purpleclay/gitz/gittest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStash(t *testing.T) {
+	gittest.InitRepository(t, gittest.WithCommittedFiles("main.go"))
+	overwriteFile(t, "main.go", "package main")
+
+	client, _ := git.NewClient()
+	_, err := client.Stash()
+	require.NoError(t, err)
+
+	clean, err := client.Clean()
+	require.NoError(t, err)
+	assert.True(t, clean)
+
+	_, err = client.StashPop("")
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{" M main.go"}, gittest.PorcelainStatus(t))
+}
+
+func TestStashWithStashIncludeUntracked(t *testing.T) {
+	gittest.InitRepository(t, gittest.WithFiles("main.go"))
+
+	client, _ := git.NewClient()
+	_, err := client.Stash(git.WithStashIncludeUntracked())
+	require.NoError(t, err)
+
+	assert.Empty(t, gittest.PorcelainStatus(t))
+}
+
+func TestStashWithStashKeepIndex(t *testing.T) {
+	gittest.InitRepository(t, gittest.WithCommittedFiles("main.go", "doc.go"))
+	overwriteFile(t, "main.go", "package main")
+	overwriteFile(t, "doc.go", "package main")
+	gittest.StageFile(t, "main.go")
+
+	client, _ := git.NewClient()
+	_, err := client.Stash(git.WithStashKeepIndex())
+	require.NoError(t, err)
+
+	assert.ElementsMatch(t, []string{"M  main.go"}, gittest.PorcelainStatus(t))
+}
+
+func TestStashList(t *testing.T) {
+	gittest.InitRepository(t, gittest.WithCommittedFiles("main.go"))
+	overwriteFile(t, "main.go", "package main")
+	gittest.MustExec(t, "git stash push -m 'first stash'")
+	overwriteFile(t, "main.go", "package app")
+	gittest.MustExec(t, "git stash push")
+
+	client, _ := git.NewClient()
+	entries, err := client.StashList()
+	require.NoError(t, err)
+
+	require.Len(t, entries, 2)
+	assert.Equal(t, "stash@{0}", entries[0].Ref)
+	assert.Equal(t, gittest.DefaultBranch, entries[0].Branch)
+	assert.Contains(t, entries[0].Message, "include test files")
+
+	assert.Equal(t, git.StashEntry{
+		Ref:     "stash@{1}",
+		Branch:  gittest.DefaultBranch,
+		Message: "first stash",
+	}, entries[1])
+}
+
+func TestStashListEmpty(t *testing.T) {
+	gittest.InitRepository(t)
+
+	client, _ := git.NewClient()
+	entries, err := client.StashList()
+	require.NoError(t, err)
+
+	assert.Empty(t, entries)
+}
+
+func TestStashApplyAndDrop(t *testing.T) {
+	gittest.InitRepository(t, gittest.WithCommittedFiles("main.go"))
+	overwriteFile(t, "main.go", "package main")
+
+	client, _ := git.NewClient()
+	_, err := client.Stash(git.WithStashMessage("work in progress"))
+	require.NoError(t, err)
+
+	_, err = client.StashApply("stash@{0}")
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{" M main.go"}, gittest.PorcelainStatus(t))
+
+	_, err = client.StashDrop("stash@{0}")
+	require.NoError(t, err)
+
+	entries, err := client.StashList()
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+}
+
+func TestStashDropQuotedRef(t *testing.T) {
+	gittest.InitRepository(t)
+
+	client, _ := git.NewClient()
+	_, err := client.StashDrop("it's")
+
+	var gitErr git.ErrGitExecCommand
+	require.ErrorAs(t, err, &gitErr)
+	assert.Equal(t, `git stash drop 'it'\''s'`, gitErr.Cmd)
+}
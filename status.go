@@ -4,6 +4,8 @@ import (
 	"bufio"
 	"fmt"
 	"strings"
+
+	"github.com/purpleclay/gitz/scan"
 )
 
 // FileStatusIndicator contains a single character that represents
@@ -73,6 +75,28 @@ type StatusOption func(*statusOptions)
 type statusOptions struct {
 	IgnoreRenames   bool
 	IgnoreUntracked bool
+	ShowIgnored     bool
+	UntrackedFiles  string
+}
+
+func (o statusOptions) String() string {
+	var buf strings.Builder
+
+	if o.IgnoreRenames {
+		buf.WriteString(" --no-renames")
+	}
+
+	if o.IgnoreUntracked {
+		buf.WriteString(" --untracked-files=no")
+	} else if o.UntrackedFiles != "" {
+		buf.WriteString(" --untracked-files=" + o.UntrackedFiles)
+	}
+
+	if o.ShowIgnored {
+		buf.WriteString(" --ignored")
+	}
+
+	return buf.String()
 }
 
 // WithIgnoreRenames will turn off rename detection, removing any renamed
@@ -91,6 +115,31 @@ func WithIgnoreUntracked() StatusOption {
 	}
 }
 
+// WithShowIgnored will include any files ignored by git, such as those
+// excluded by a .gitignore file, within the retrieved file statuses. An
+// ignored file is reported with the [Ignored] indicator
+func WithShowIgnored() StatusOption {
+	return func(opts *statusOptions) {
+		opts.ShowIgnored = true
+	}
+}
+
+// WithShowUntrackedFiles changes how untracked files are reported. By default,
+// only the untracked directory is reported, hiding any files it contains.
+// Supported modes are:
+//   - all: report all untracked files, including those within untracked directories
+//   - normal: report untracked files and directories
+//   - no: do not report any untracked files
+//
+// Any leading and trailing whitespace will be trimmed from the mode, allowing
+// an empty mode to be ignored. This option has no effect if combined with
+// [WithIgnoreUntracked]
+func WithShowUntrackedFiles(mode string) StatusOption {
+	return func(opts *statusOptions) {
+		opts.UntrackedFiles = strings.TrimSpace(mode)
+	}
+}
+
 // PorcelainStatus identifies if there are any changes within the current
 // repository (working directory) and returns them in the parseable
 // porcelain v1 format
@@ -100,23 +149,244 @@ func (c *Client) PorcelainStatus(opts ...StatusOption) ([]FileStatus, error) {
 		opt(options)
 	}
 
-	var buf strings.Builder
-	buf.WriteString("git status --porcelain")
-
-	if options.IgnoreRenames {
-		buf.WriteString(" --no-renames")
+	log, err := c.Exec("git status --porcelain" + options.String())
+	if err != nil {
+		return nil, err
 	}
 
-	if options.IgnoreUntracked {
-		buf.WriteString(" --untracked-files=no")
+	return parsePorcelainV1(log), nil
+}
+
+// StatusEntryType identifies the type of entry reported for a file when
+// using the porcelain v2 format. Based on the git specification:
+// https://git-scm.com/docs/git-status#_porcelain_format_version_2
+type StatusEntryType byte
+
+const (
+	IgnoredEntry   StatusEntryType = '!'
+	OrdinaryEntry  StatusEntryType = '1'
+	RenamedEntry   StatusEntryType = '2'
+	UnmergedEntry  StatusEntryType = 'u'
+	UntrackedEntry StatusEntryType = '?'
+)
+
+// FileStatusV2 represents the status of a file within a repository, as
+// reported by the porcelain v2 format. It contains richer details than
+// [FileStatus], such as the similarity score of a renamed file
+type FileStatusV2 struct {
+	// Type of entry reported for the file
+	Type StatusEntryType
+
+	// Indicators is a two character array that contains the current
+	// status of a file within both the current index and the working
+	// repository tree. Identical to [FileStatus.Indicators]
+	Indicators [2]FileStatusIndicator
+
+	// Submodule contains a four character field that describes the
+	// state of a submodule. N... is reported if the file is not a
+	// submodule. Empty for both untracked and ignored files
+	Submodule string
+
+	// HeadHash contains the object name of the file within HEAD. Empty
+	// for both untracked and ignored files
+	HeadHash string
+
+	// IndexHash contains the object name of the file within the index.
+	// Empty for both untracked and ignored files
+	IndexHash string
+
+	// Score contains the similarity percentage of a renamed or copied
+	// file. Only set for a [RenamedEntry]
+	Score int
+
+	// Path of the file relative to the root of the
+	// current repository
+	Path string
+
+	// OldPath contains the original path of a renamed or copied file.
+	// Only set for a [RenamedEntry]
+	OldPath string
+}
+
+// PorcelainStatusV2 identifies if there are any changes within the current
+// repository (working directory) and returns them in the parseable porcelain
+// v2 format. The same options as [Client.PorcelainStatus] are supported. Raw
+// output is parsed from the following command:
+//
+//	git status --porcelain=v2 -z
+func (c *Client) PorcelainStatusV2(opts ...StatusOption) ([]FileStatusV2, error) {
+	options := &statusOptions{}
+	for _, opt := range opts {
+		opt(options)
 	}
 
-	log, err := c.Exec(buf.String())
+	log, err := c.Exec("git status --porcelain=v2 -z" + options.String())
 	if err != nil {
 		return nil, err
 	}
 
-	return parsePorcelainV1(log), nil
+	return parsePorcelainV2(log), nil
+}
+
+func parsePorcelainV2(log string) []FileStatusV2 {
+	var statuses []FileStatusV2
+
+	records := strings.Split(log, "\x00")
+	for i := 0; i < len(records); i++ {
+		record := records[i]
+		if record == "" {
+			continue
+		}
+
+		status := FileStatusV2{Type: StatusEntryType(record[0])}
+
+		switch status.Type {
+		case OrdinaryEntry:
+			// 1 <XY> <sub> <mH> <mI> <mW> <hH> <hI> <path>
+			fields := strings.SplitN(record, " ", 9)
+			if len(fields) < 9 {
+				continue
+			}
+			status.setEntry(fields)
+			status.Path = fields[8]
+		case RenamedEntry:
+			// 2 <XY> <sub> <mH> <mI> <mW> <hH> <hI> <X><score> <path>\0<origPath>
+			fields := strings.SplitN(record, " ", 10)
+			if len(fields) < 10 {
+				continue
+			}
+			status.setEntry(fields)
+			status.Score = mustInt(fields[8][1:])
+			status.Path = fields[9]
+			if i+1 < len(records) {
+				status.OldPath = records[i+1]
+				i++
+			}
+		case UnmergedEntry:
+			// u <XY> <sub> <m1> <m2> <m3> <mW> <h1> <h2> <h3> <path>
+			fields := strings.SplitN(record, " ", 11)
+			if len(fields) < 11 {
+				continue
+			}
+			status.Indicators = porcelainV2Indicators(fields[1])
+			status.Submodule = fields[2]
+			status.Path = fields[10]
+		case UntrackedEntry, IgnoredEntry:
+			// ? <path> or ! <path>
+			status.Indicators = [2]FileStatusIndicator{
+				FileStatusIndicator(status.Type),
+				FileStatusIndicator(status.Type),
+			}
+			status.Path = record[2:]
+		default:
+			continue
+		}
+
+		statuses = append(statuses, status)
+	}
+
+	return statuses
+}
+
+func (f *FileStatusV2) setEntry(fields []string) {
+	f.Indicators = porcelainV2Indicators(fields[1])
+	f.Submodule = fields[2]
+	f.HeadHash = fields[6]
+	f.IndexHash = fields[7]
+}
+
+func porcelainV2Indicators(xy string) [2]FileStatusIndicator {
+	// Porcelain v2 uses a dot to represent an unmodified file, unlike
+	// v1 which uses a space
+	indicators := [2]FileStatusIndicator{Unmodified, Unmodified}
+	for i := 0; i < len(xy) && i < 2; i++ {
+		if xy[i] != '.' {
+			indicators[i] = FileStatusIndicator(xy[i])
+		}
+	}
+
+	return indicators
+}
+
+// StatusSummary contains details about the current branch, including how
+// it tracks against its upstream branch, and the status of all files within
+// the current repository (working directory)
+type StatusSummary struct {
+	// Branch contains the name of the current branch. Empty if
+	// the repository is in a detached HEAD state
+	Branch string
+
+	// Upstream contains the name of the upstream branch being
+	// tracked by the current branch, if one has been set
+	Upstream string
+
+	// Ahead contains the number of commits on the current branch
+	// that do not exist on the upstream branch
+	Ahead int
+
+	// Behind contains the number of commits on the upstream branch
+	// that do not exist on the current branch
+	Behind int
+
+	// Files contains the status of each changed file
+	Files []FileStatus
+}
+
+// Status identifies if there are any changes within the current repository
+// (working directory), along with details about the current branch and its
+// upstream branch. The same options as [Client.PorcelainStatus] are supported.
+// Raw output is parsed from the following command:
+//
+//	git status --porcelain=v1 --branch
+func (c *Client) Status(opts ...StatusOption) (StatusSummary, error) {
+	options := &statusOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	log, err := c.Exec("git status --porcelain=v1 --branch" + options.String())
+	if err != nil {
+		return StatusSummary{}, err
+	}
+
+	// The branch header is always the first line
+	header, files, _ := strings.Cut(log, "\n")
+
+	summary := parseStatusBranch(header)
+	summary.Files = parsePorcelainV1(files)
+	return summary, nil
+}
+
+func parseStatusBranch(header string) StatusSummary {
+	var summary StatusSummary
+
+	// Expected format of the header:
+	// ## <branch>...<upstream> [ahead <n>, behind <n>]
+	header = strings.TrimPrefix(header, "## ")
+
+	for _, prefix := range []string{"No commits yet on ", "Initial commit on "} {
+		if strings.HasPrefix(header, prefix) {
+			summary.Branch = strings.TrimPrefix(header, prefix)
+			return summary
+		}
+	}
+
+	if strings.HasPrefix(header, "HEAD (no branch)") {
+		return summary
+	}
+
+	branches, tracking, _ := strings.Cut(header, " [")
+	summary.Branch, summary.Upstream, _ = strings.Cut(branches, "...")
+
+	for _, track := range strings.Split(strings.TrimSuffix(tracking, "]"), ", ") {
+		if n, found := strings.CutPrefix(track, "ahead "); found {
+			summary.Ahead = mustInt(n)
+		} else if n, found := strings.CutPrefix(track, "behind "); found {
+			summary.Behind = mustInt(n)
+		}
+	}
+
+	return summary
 }
 
 // Clean determines if the current repository (working directory) is in
@@ -126,6 +396,30 @@ func (c *Client) Clean() (bool, error) {
 	return len(statuses) == 0, err
 }
 
+// Conflicts identifies all files with unresolved merge conflicts within the
+// current repository (working directory). Conflicts can arise from a failed
+// merge, rebase or cherry-pick. Paths are relative to the root of the
+// repository. Raw output is parsed from the following command:
+//
+//	git diff --name-only -z --diff-filter=U
+func (c *Client) Conflicts() ([]string, error) {
+	out, err := c.Exec("git diff --name-only -z --diff-filter=U")
+	if err != nil {
+		return nil, err
+	}
+
+	var conflicts []string
+
+	scanner := bufio.NewScanner(strings.NewReader(out))
+	scanner.Split(scan.NullTerminatedLines())
+
+	for scanner.Scan() {
+		conflicts = append(conflicts, scanner.Text())
+	}
+
+	return conflicts, nil
+}
+
 func parsePorcelainV1(log string) []FileStatus {
 	var statuses []FileStatus
 
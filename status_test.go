@@ -35,6 +35,45 @@ func TestPorcelainStatusWithIgnoreUntracked(t *testing.T) {
 	assert.ElementsMatch(t, []string{"A  go.mod"}, []string{statuses[0].String()})
 }
 
+func TestPorcelainStatusWithShowUntrackedFiles(t *testing.T) {
+	gittest.InitRepository(t, gittest.WithFiles("dir/a.txt", "dir/b.txt"))
+
+	client, _ := git.NewClient()
+	statuses, err := client.PorcelainStatus()
+	require.NoError(t, err)
+
+	require.Len(t, statuses, 1)
+	assert.Equal(t, "?? dir/", statuses[0].String())
+
+	statuses, err = client.PorcelainStatus(git.WithShowUntrackedFiles("all"))
+	require.NoError(t, err)
+
+	require.Len(t, statuses, 2)
+	assert.ElementsMatch(t,
+		[]string{"?? dir/a.txt", "?? dir/b.txt"},
+		[]string{statuses[0].String(), statuses[1].String()},
+	)
+}
+
+func TestPorcelainStatusWithShowIgnored(t *testing.T) {
+	gittest.InitRepository(t,
+		gittest.WithCommittedFiles(".gitignore"),
+		gittest.WithFileContent(".gitignore", "*.log"),
+		gittest.WithFiles("debug.log"))
+
+	client, _ := git.NewClient()
+	statuses, err := client.PorcelainStatus()
+	require.NoError(t, err)
+	assert.Empty(t, statuses)
+
+	statuses, err = client.PorcelainStatus(git.WithShowIgnored())
+	require.NoError(t, err)
+
+	require.Len(t, statuses, 1)
+	assert.Equal(t, [2]git.FileStatusIndicator{git.Ignored, git.Ignored}, statuses[0].Indicators)
+	assert.Equal(t, "debug.log", statuses[0].Path)
+}
+
 func TestPorcelainStatusWithIgnoreRenames(t *testing.T) {
 	gittest.InitRepository(t, gittest.WithFiles("go.mod"))
 	gittest.Move(t, "README.md", "CONTRIBUTING.md")
@@ -71,3 +110,123 @@ func TestCleanWithStagedChanges(t *testing.T) {
 
 	assert.False(t, clean)
 }
+
+func TestConflicts(t *testing.T) {
+	gittest.InitRepository(t, gittest.WithCommittedFiles("conflict.txt", "merged.txt"))
+	conflictingBranches(t, "conflict.txt")
+	gittest.Checkout(t, "feature")
+	overwriteFile(t, "merged.txt", "changed on feature")
+	gittest.StageFile(t, "merged.txt")
+	gittest.Commit(t, "fix: a change that merges cleanly")
+
+	gittest.Checkout(t, gittest.DefaultBranch)
+	gittest.Exec(t, "git merge feature")
+
+	client, _ := git.NewClient()
+	conflicts, err := client.Conflicts()
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"conflict.txt"}, conflicts)
+}
+
+func TestConflictsSpecialCharacters(t *testing.T) {
+	gittest.InitRepository(t, gittest.WithCommittedFiles("conflict\nfile.txt"))
+	conflictingBranches(t, "conflict\nfile.txt")
+	gittest.Exec(t, "git merge feature")
+
+	client, _ := git.NewClient()
+	conflicts, err := client.Conflicts()
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"conflict\nfile.txt"}, conflicts)
+}
+
+func TestConflictsNone(t *testing.T) {
+	gittest.InitRepository(t, gittest.WithStagedFiles("staged.txt"))
+
+	client, _ := git.NewClient()
+	conflicts, err := client.Conflicts()
+	require.NoError(t, err)
+
+	assert.Empty(t, conflicts)
+}
+
+func TestStatus(t *testing.T) {
+	gittest.InitRepository(t,
+		gittest.WithLocalCommits("feat: a brand new feature", "docs: document the feature"),
+		gittest.WithStagedFiles("go.mod"))
+
+	client, _ := git.NewClient()
+	status, err := client.Status()
+	require.NoError(t, err)
+
+	assert.Equal(t, gittest.DefaultBranch, status.Branch)
+	assert.Equal(t, "origin/"+gittest.DefaultBranch, status.Upstream)
+	assert.Equal(t, 2, status.Ahead)
+	assert.Equal(t, 0, status.Behind)
+	require.Len(t, status.Files, 1)
+	assert.Equal(t, "A  go.mod", status.Files[0].String())
+}
+
+func TestStatusBehind(t *testing.T) {
+	log := `(main, origin/main) feat: a brand new feature on the remote
+docs: document the feature on the remote`
+	gittest.InitRepository(t,
+		gittest.WithRemoteLog(log),
+		gittest.WithLocalCommits("fix: a local fix"))
+	gittest.Exec(t, "git fetch")
+
+	client, _ := git.NewClient()
+	status, err := client.Status()
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, status.Ahead)
+	assert.Equal(t, 2, status.Behind)
+	assert.Empty(t, status.Files)
+}
+
+func TestStatusDetachedHead(t *testing.T) {
+	gittest.InitRepository(t)
+	gittest.Checkout(t, gittest.LastCommit(t).Hash)
+
+	client, _ := git.NewClient()
+	status, err := client.Status()
+	require.NoError(t, err)
+
+	assert.Empty(t, status.Branch)
+	assert.Empty(t, status.Upstream)
+}
+
+func TestPorcelainStatusV2(t *testing.T) {
+	gittest.InitRepository(t,
+		gittest.WithCommittedFiles("main.go"),
+		gittest.WithFiles("go.mod"))
+	gittest.Move(t, "README.md", "CONTRIBUTING.md")
+	overwriteFile(t, "main.go", "package main")
+
+	client, _ := git.NewClient()
+	statuses, err := client.PorcelainStatusV2()
+	require.NoError(t, err)
+
+	require.Len(t, statuses, 3)
+
+	modified := statuses[1]
+	assert.Equal(t, git.OrdinaryEntry, modified.Type)
+	assert.Equal(t, [2]git.FileStatusIndicator{git.Unmodified, git.Modified}, modified.Indicators)
+	assert.Equal(t, "N...", modified.Submodule)
+	assert.Equal(t, gittest.ObjectRef(t, "main.go"), modified.HeadHash)
+	assert.Equal(t, "main.go", modified.Path)
+
+	renamed := statuses[0]
+	assert.Equal(t, git.RenamedEntry, renamed.Type)
+	assert.Equal(t, [2]git.FileStatusIndicator{git.Renamed, git.Unmodified}, renamed.Indicators)
+	assert.Equal(t, 100, renamed.Score)
+	assert.Equal(t, "CONTRIBUTING.md", renamed.Path)
+	assert.Equal(t, "README.md", renamed.OldPath)
+
+	untracked := statuses[2]
+	assert.Equal(t, git.UntrackedEntry, untracked.Type)
+	assert.Equal(t, [2]git.FileStatusIndicator{git.Untracked, git.Untracked}, untracked.Indicators)
+	assert.Equal(t, "go.mod", untracked.Path)
+	assert.Empty(t, untracked.Submodule)
+}
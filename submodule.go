@@ -0,0 +1,139 @@
+package git
+
+import (
+	"fmt"
+	"strings"
+)
+
+// SubmoduleState contains a single character that represents the state
+// of a submodule within a git repository. Based on the git specification:
+// https://git-scm.com/docs/git-submodule#Documentation/git-submodule.txt-status--cached--recursive--ltpathgt82308203
+type SubmoduleState byte
+
+const (
+	SubmoduleConflict      SubmoduleState = 'U'
+	SubmoduleInSync        SubmoduleState = ' '
+	SubmoduleModified      SubmoduleState = '+'
+	SubmoduleUninitialized SubmoduleState = '-'
+)
+
+// SubmoduleStatus represents the status of a submodule within a repository
+type SubmoduleStatus struct {
+	// Hash of the commit currently checked out within the submodule. If
+	// the submodule is not initialized, this will be the commit recorded
+	// by the superproject
+	Hash string
+
+	// Path of the submodule relative to the root of the current repository
+	Path string
+
+	// State of the submodule in relation to the commit recorded
+	// by the superproject
+	State SubmoduleState
+}
+
+// SubmoduleAdd adds a repository, identified by its URL, as a submodule
+// of the current repository (working directory). The submodule is cloned
+// into the provided path, which is relative to the root of the current
+// repository. Both the submodule and the generated .gitmodules file are
+// staged, ready to be committed
+func (c *Client) SubmoduleAdd(url, path string) (string, error) {
+	return c.Exec(fmt.Sprintf("git submodule add %s '%s'",
+		strings.TrimSpace(url), strings.TrimSpace(path)))
+}
+
+// SubmoduleUpdateOption provides a way for setting specific options during
+// a submodule update operation. Each supported option can customize the way
+// submodules are updated within the current repository (working directory)
+type SubmoduleUpdateOption func(*submoduleUpdateOptions)
+
+type submoduleUpdateOptions struct {
+	Init      bool
+	Recursive bool
+}
+
+// WithSubmoduleInit will initialize any submodules that have not yet
+// been initialized before updating them
+func WithSubmoduleInit() SubmoduleUpdateOption {
+	return func(opts *submoduleUpdateOptions) {
+		opts.Init = true
+	}
+}
+
+// WithSubmoduleRecursive will update any nested submodules within
+// each submodule
+func WithSubmoduleRecursive() SubmoduleUpdateOption {
+	return func(opts *submoduleUpdateOptions) {
+		opts.Recursive = true
+	}
+}
+
+// SubmoduleUpdate updates all registered submodules within the current
+// repository (working directory), ensuring they are checked out at the
+// commit recorded by the superproject
+func (c *Client) SubmoduleUpdate(opts ...SubmoduleUpdateOption) (string, error) {
+	options := &submoduleUpdateOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	var buf strings.Builder
+	buf.WriteString("git submodule update")
+
+	if options.Init {
+		buf.WriteString(" --init")
+	}
+
+	if options.Recursive {
+		buf.WriteString(" --recursive")
+	}
+
+	return c.Exec(buf.String())
+}
+
+// SubmoduleStatus retrieves the status of all submodules within the
+// current repository (working directory). Raw output is parsed from
+// the following command:
+//
+//	git submodule status
+func (c *Client) SubmoduleStatus() ([]SubmoduleStatus, error) {
+	out, err := c.Exec("git submodule status")
+	if err != nil {
+		return nil, err
+	}
+
+	return parseSubmoduleStatus(out), nil
+}
+
+func parseSubmoduleStatus(out string) []SubmoduleStatus {
+	if out == "" {
+		return nil
+	}
+
+	var statuses []SubmoduleStatus
+	for _, line := range strings.Split(out, "\n") {
+		if len(line) < 2 {
+			continue
+		}
+
+		// <state><hash> <path>[ (<describe>)]
+		hash, path, found := strings.Cut(line[1:], " ")
+		if !found {
+			continue
+		}
+
+		if strings.HasSuffix(path, ")") {
+			if idx := strings.LastIndex(path, " ("); idx > -1 {
+				path = path[:idx]
+			}
+		}
+
+		statuses = append(statuses, SubmoduleStatus{
+			Hash:  hash,
+			Path:  path,
+			State: SubmoduleState(line[0]),
+		})
+	}
+
+	return statuses
+}
@@ -0,0 +1,96 @@
+package git_test
+
+import (
+	"testing"
+
+	git "github.com/purpleclay/gitz"
+	"github.com/purpleclay/gitz/gittest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubmoduleAdd(t *testing.T) {
+	client := submoduleRepository(t, "libs/submodule")
+
+	statuses, err := client.SubmoduleStatus()
+	require.NoError(t, err)
+
+	require.Len(t, statuses, 1)
+	assert.Equal(t, "libs/submodule", statuses[0].Path)
+	assert.Equal(t, git.SubmoduleInSync, statuses[0].State)
+	assert.Len(t, statuses[0].Hash, 40)
+	assert.FileExists(t, ".gitmodules")
+}
+
+func TestSubmoduleUpdateWithSubmoduleInit(t *testing.T) {
+	client := submoduleRepository(t, "libs/submodule")
+	gittest.MustExec(t, "git submodule deinit --force libs/submodule")
+
+	statuses, err := client.SubmoduleStatus()
+	require.NoError(t, err)
+	require.Len(t, statuses, 1)
+	assert.Equal(t, git.SubmoduleUninitialized, statuses[0].State)
+
+	_, err = client.SubmoduleUpdate(git.WithSubmoduleInit(), git.WithSubmoduleRecursive())
+	require.NoError(t, err)
+
+	statuses, err = client.SubmoduleStatus()
+	require.NoError(t, err)
+	require.Len(t, statuses, 1)
+	assert.Equal(t, git.SubmoduleInSync, statuses[0].State)
+}
+
+func TestSubmoduleStatusModified(t *testing.T) {
+	client := submoduleRepository(t, "libs/submodule")
+	gittest.MustExec(t, "git -C libs/submodule -c user.name=batman -c user.email=batman@dc.com commit --allow-empty -m 'feat: move submodule ahead'")
+
+	statuses, err := client.SubmoduleStatus()
+	require.NoError(t, err)
+
+	require.Len(t, statuses, 1)
+	assert.Equal(t, git.SubmoduleModified, statuses[0].State)
+}
+
+func TestSubmoduleStatusNoSubmodules(t *testing.T) {
+	gittest.InitRepository(t)
+
+	client, _ := git.NewClient()
+	statuses, err := client.SubmoduleStatus()
+
+	require.NoError(t, err)
+	assert.Empty(t, statuses)
+}
+
+func TestSubmoduleStatusPathWithSpaces(t *testing.T) {
+	client := submoduleRepository(t, "libs/bat gadgets")
+
+	statuses, err := client.SubmoduleStatus()
+	require.NoError(t, err)
+
+	require.Len(t, statuses, 1)
+	assert.Equal(t, "libs/bat gadgets", statuses[0].Path)
+	assert.Len(t, statuses[0].Hash, 40)
+}
+
+func submoduleRepository(t *testing.T, path string) *git.Client {
+	t.Helper()
+
+	gittest.InitRepository(t, gittest.WithLocalCommits("feat: a commit within the submodule"))
+	gittest.MustExec(t, "git push")
+	submodule := gittest.Remote(t)
+
+	gittest.InitRepository(t)
+
+	// Git prevents submodules from being cloned from the local file system by default
+	client, _ := git.NewClient(git.WithEnv(map[string]string{
+		"GIT_CONFIG_COUNT":   "1",
+		"GIT_CONFIG_KEY_0":   "protocol.file.allow",
+		"GIT_CONFIG_VALUE_0": "always",
+	}))
+
+	_, err := client.SubmoduleAdd(submodule, path)
+	require.NoError(t, err)
+	gittest.MustExec(t, "git commit -m 'chore: add submodule'")
+
+	return client
+}
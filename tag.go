@@ -65,23 +65,41 @@ func (k SortKey) String() string {
 type CreateTagOption func(*createTagOptions)
 
 type createTagOptions struct {
-	Annotation    string
-	CommitRef     string
-	Config        []string
-	ForceNoSigned bool
-	LocalOnly     bool
-	Signed        bool
-	SigningKey    string
+	Annotation     string
+	AnnotationFile string
+	CommitRef      string
+	Config         []string
+	Force          bool
+	ForceNoSigned  bool
+	LocalOnly      bool
+	Signed         bool
+	SigningKey     string
 }
 
 // WithAnnotation ensures the created tag is annotated with the provided
 // message. This ultimately converts the standard lightweight tag into
 // an annotated tag which is stored as a full object within the git
 // database. Any leading and trailing whitespace will automatically be
-// trimmed from the message. This allows empty messages to be ignored
+// trimmed from the message. This allows empty messages to be ignored.
+// Mutually exclusive with [WithAnnotationFile], the last provided option
+// will take precedence
 func WithAnnotation(message string) CreateTagOption {
 	return func(opts *createTagOptions) {
 		opts.Annotation = strings.TrimSpace(message)
+		opts.AnnotationFile = ""
+	}
+}
+
+// WithAnnotationFile ensures the created tag is annotated with the contents
+// of the provided file. Useful for multi-paragraph messages, such as release
+// notes, that would otherwise need escaping. Any leading and trailing whitespace
+// will automatically be trimmed from the path. This allows empty paths to be
+// ignored. Mutually exclusive with [WithAnnotation], the last provided option
+// will take precedence
+func WithAnnotationFile(path string) CreateTagOption {
+	return func(opts *createTagOptions) {
+		opts.AnnotationFile = strings.TrimSpace(path)
+		opts.Annotation = ""
 	}
 }
 
@@ -94,6 +112,19 @@ func WithCommitRef(ref string) CreateTagOption {
 	}
 }
 
+// WithTagForce will replace an existing tag with the same name, rather than
+// failing. Unless the tag is local only, it will also be force pushed to the
+// remote, overwriting the existing tag.
+//
+// Use with caution. Moving a tag that has already been published can break
+// anyone relying on it, as git will not update tags that have already
+// been fetched
+func WithTagForce() CreateTagOption {
+	return func(opts *createTagOptions) {
+		opts.Force = true
+	}
+}
+
 // WithLocalOnly ensures the created tag will not be pushed back to
 // the remote and be kept as a local tag only
 func WithLocalOnly() CreateTagOption {
@@ -181,8 +212,12 @@ func (c *Client) Tag(tag string, opts ...CreateTagOption) (string, error) {
 	}
 	buf.WriteString(" tag")
 
+	if options.Force {
+		buf.WriteString(" -f")
+	}
+
 	if options.Signed {
-		if options.Annotation == "" {
+		if options.Annotation == "" && options.AnnotationFile == "" {
 			options.Annotation = "created tag " + tag
 		}
 		buf.WriteString(" -s")
@@ -198,6 +233,8 @@ func (c *Client) Tag(tag string, opts ...CreateTagOption) (string, error) {
 
 	if options.Annotation != "" {
 		buf.WriteString(fmt.Sprintf(" -a -m '%s'", options.Annotation))
+	} else if options.AnnotationFile != "" {
+		buf.WriteString(fmt.Sprintf(" -a -F '%s'", escapeQuotes(options.AnnotationFile)))
 	}
 	buf.WriteString(fmt.Sprintf(" '%s'", tag))
 
@@ -214,6 +251,10 @@ func (c *Client) Tag(tag string, opts ...CreateTagOption) (string, error) {
 		return out, nil
 	}
 
+	if options.Force {
+		return c.Exec(fmt.Sprintf("git push --force origin '%s'", tag))
+	}
+
 	return c.Exec(fmt.Sprintf("git push origin '%s'", tag))
 }
 
@@ -268,8 +309,11 @@ func (c *Client) TagBatchAt(pairs []string, opts ...CreateTagOption) (string, er
 type ListTagsOption func(*listTagsOptions)
 
 type listTagsOptions struct {
+	Contains     string
 	Count        int
 	Filters      []TagFilter
+	Merged       string
+	PointsAt     string
 	ShellGlobs   []string
 	SemanticSort bool
 	SortBy       []string
@@ -308,6 +352,36 @@ func WithFilters(filters ...TagFilter) ListTagsOption {
 	}
 }
 
+// WithPointsAt limits the retrieved tags to only those that point at
+// the provided reference, such as a commit. Any leading and trailing
+// whitespace will be trimmed from the reference, allowing an empty
+// reference to be ignored
+func WithPointsAt(ref string) ListTagsOption {
+	return func(opts *listTagsOptions) {
+		opts.PointsAt = strings.TrimSpace(ref)
+	}
+}
+
+// WithTagContains limits the retrieved tags to only those that contain
+// the provided reference within their history. Any leading and trailing
+// whitespace will be trimmed from the reference, allowing an empty
+// reference to be ignored
+func WithTagContains(ref string) ListTagsOption {
+	return func(opts *listTagsOptions) {
+		opts.Contains = strings.TrimSpace(ref)
+	}
+}
+
+// WithTagMerged limits the retrieved tags to only those that are reachable
+// from the provided reference, ensuring they have been merged into its
+// history. Any leading and trailing whitespace will be trimmed from the
+// reference, allowing an empty reference to be ignored
+func WithTagMerged(ref string) ListTagsOption {
+	return func(opts *listTagsOptions) {
+		opts.Merged = strings.TrimSpace(ref)
+	}
+}
+
 // WithShellGlob limits the number of tags that will be retrieved, by only
 // returning tags that match a given [Shell Glob] pattern. If multiple
 // patterns are provided, tags will be retrieved if they match against
@@ -366,9 +440,23 @@ func (c *Client) Tags(opts ...ListTagsOption) ([]string, error) {
 		config = "-c versionsort.suffix=-"
 	}
 
-	tags, err := c.Exec(fmt.Sprintf("git %s for-each-ref %s --format='%%(refname:lstrip=2)' %s --color=never",
+	var refFilters []string
+	if options.PointsAt != "" {
+		refFilters = append(refFilters, fmt.Sprintf("--points-at='%s'", escapeQuotes(options.PointsAt)))
+	}
+
+	if options.Merged != "" {
+		refFilters = append(refFilters, fmt.Sprintf("--merged='%s'", escapeQuotes(options.Merged)))
+	}
+
+	if options.Contains != "" {
+		refFilters = append(refFilters, fmt.Sprintf("--contains='%s'", escapeQuotes(options.Contains)))
+	}
+
+	tags, err := c.Exec(fmt.Sprintf("git %s for-each-ref %s %s --format='%%(refname:lstrip=2)' %s --color=never",
 		config,
 		strings.Join(options.SortBy, " "),
+		strings.Join(refFilters, " "),
 		strings.Join(options.ShellGlobs, " ")))
 	if err != nil {
 		return nil, err
@@ -388,6 +476,22 @@ func (c *Client) Tags(opts ...ListTagsOption) ([]string, error) {
 	return splitTags, nil
 }
 
+// TagExists checks if a tag exists locally within the current repository
+// (working directory). Rather than returning an error, false is returned
+// if the tag does not exist
+func (c *Client) TagExists(tag string) (bool, error) {
+	_, err := c.Exec(fmt.Sprintf("git show-ref --tags --verify --quiet 'refs/tags/%s'", tag))
+	if err != nil {
+		// Git exits with an exit code of 1 if the tag does not exist
+		if isExitCode(err, 1) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return true, nil
+}
+
 func filterTags(tags []string, filters []TagFilter) []string {
 	filtered := tags
 	for _, filter := range filters {
@@ -553,3 +657,18 @@ func (c *Client) DeleteTags(tags []string, opts ...DeleteTagsOption) (string, er
 
 	return c.Push(WithDeleteRefSpecs(tags...))
 }
+
+// DeleteTagsMatching will attempt to delete all tags that match a given
+// [Shell Glob] pattern from the current repository and push those deletions
+// back to the remote in a single transaction. Nothing is deleted if no tags
+// match the pattern
+//
+// [Shell Glob]: https://tldp.org/LDP/GNU-Linux-Tools-Summary/html/x11655.htm
+func (c *Client) DeleteTagsMatching(pattern string, opts ...DeleteTagsOption) (string, error) {
+	tags, err := c.Tags(WithShellGlob(pattern))
+	if err != nil {
+		return "", err
+	}
+
+	return c.DeleteTags(tags, opts...)
+}
@@ -2,6 +2,7 @@ package git_test
 
 import (
 	"fmt"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -50,6 +51,27 @@ func TestTagWithAnnotation(t *testing.T) {
 	assert.Contains(t, out, "created tag 0.1.0")
 }
 
+func TestTagWithAnnotationFile(t *testing.T) {
+	gittest.InitRepository(t)
+	notes := `release 0.1.0
+
+Features:
+- support for basic cloning
+- support for tag sorting and filtering`
+	path := filepath.Join(t.TempDir(), "RELEASE_NOTES.md")
+	gittest.WriteFile(t, path, notes, 0o644)
+
+	client, _ := git.NewClient()
+	_, err := client.Tag("0.1.0", git.WithAnnotationFile(path))
+	require.NoError(t, err)
+
+	tags, err := client.ShowTags("0.1.0")
+	require.NoError(t, err)
+
+	require.NotNil(t, tags["0.1.0"].Annotation)
+	assert.Equal(t, notes, tags["0.1.0"].Annotation.Message)
+}
+
 func TestTagWithSkipSigning(t *testing.T) {
 	gittest.InitRepository(t)
 	gittest.ConfigSet(t, "user.signingkey", "DOES-NOT-EXIST", "tag.gpgsign", "true")
@@ -98,6 +120,28 @@ test: expand current test suite using golden files`
 	assert.Contains(t, out, "commit "+glog[1].Hash)
 }
 
+func TestTagWithTagForce(t *testing.T) {
+	log := `(tag: 0.1.0) ci: add extra job to workflow for running golden file tests
+test: expand current test suite using golden files`
+	gittest.InitRepository(t, gittest.WithLog(log))
+	glog := gittest.Log(t)
+	require.Len(t, glog, 3)
+
+	client, _ := git.NewClient()
+	_, err := client.Tag("0.1.0", git.WithCommitRef(glog[1].Hash))
+	require.Error(t, err)
+
+	_, err = client.Tag("0.1.0", git.WithTagForce(), git.WithCommitRef(glog[1].Hash))
+	require.NoError(t, err)
+
+	tags, err := client.ShowTags("0.1.0")
+	require.NoError(t, err)
+	assert.Equal(t, glog[1].Message, tags["0.1.0"].Commit.Message)
+
+	remoteTag := gittest.MustExec(t, "git ls-remote --tags origin 0.1.0")
+	assert.Contains(t, remoteTag, glog[1].Hash)
+}
+
 func TestTagBatch(t *testing.T) {
 	gittest.InitRepository(t, gittest.WithLog("fix: race condition when writing to map"))
 	glog := gittest.Log(t)
@@ -142,6 +186,22 @@ func TestDeleteTags(t *testing.T) {
 	assert.Empty(t, remoteTags)
 }
 
+func TestDeleteTagsMatching(t *testing.T) {
+	log := `(tag: 0.2.0, tag: 0.2.0-beta.2) feat(ui): add new fancy button to ui
+(tag: 0.2.0-beta.1, tag: 0.1.0-rc.1) feat(ui): add new fancy menu to ui`
+	gittest.InitRepository(t, gittest.WithLog(log))
+
+	client, _ := git.NewClient()
+	_, err := client.DeleteTagsMatching("*-beta.*")
+	require.NoError(t, err)
+
+	localTags := gittest.Tags(t)
+	assert.ElementsMatch(t, []string{"0.1.0-rc.1", "0.2.0"}, localTags)
+
+	remoteTags := gittest.RemoteTags(t)
+	assert.ElementsMatch(t, []string{"0.1.0-rc.1", "0.2.0"}, remoteTags)
+}
+
 func TestDeleteTagsLocally(t *testing.T) {
 	log := "(tag: 0.1.0, tag: 0.2.0) fix: indexed data is not in the correct order"
 	gittest.InitRepository(t, gittest.WithLog(log))
@@ -191,6 +251,65 @@ func TestTagsWithShellGlob(t *testing.T) {
 	assert.ElementsMatch(t, []string{"0.1.0", "0.2.0"}, tags)
 }
 
+func TestTagsWithPointsAt(t *testing.T) {
+	log := `(tag: 0.2.0, tag: v1) feat: add support for tag sorting and filtering
+(tag: 0.1.0) feat: add support for basic cloning`
+	gittest.InitRepository(t, gittest.WithLog(log))
+	glog := gittest.Log(t)
+
+	client, _ := git.NewClient()
+	tags, err := client.Tags(git.WithPointsAt(glog[1].Hash))
+
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"0.1.0"}, tags)
+}
+
+func TestTagsWithTagMerged(t *testing.T) {
+	log := `(tag: 0.3.0) feat: add support for tag filtering
+(tag: 0.2.0) feat: add support for tag sorting
+(tag: 0.1.0) feat: add support for basic cloning`
+	gittest.InitRepository(t, gittest.WithLog(log))
+
+	client, _ := git.NewClient()
+	tags, err := client.Tags(git.WithTagMerged("0.2.0"))
+
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"0.1.0", "0.2.0"}, tags)
+}
+
+func TestTagsWithTagContains(t *testing.T) {
+	log := `(tag: 0.3.0) feat: add support for tag filtering
+(tag: 0.2.0) feat: add support for tag sorting
+(tag: 0.1.0) feat: add support for basic cloning`
+	gittest.InitRepository(t, gittest.WithLog(log))
+
+	client, _ := git.NewClient()
+	tags, err := client.Tags(git.WithTagContains("0.2.0"))
+
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"0.2.0", "0.3.0"}, tags)
+}
+
+func TestTagExists(t *testing.T) {
+	gittest.InitRepository(t, gittest.WithLog("(tag: 0.1.0) feat: add support for basic cloning"))
+
+	client, _ := git.NewClient()
+	exists, err := client.TagExists("0.1.0")
+
+	require.NoError(t, err)
+	assert.True(t, exists)
+}
+
+func TestTagExistsMissingTag(t *testing.T) {
+	gittest.InitRepository(t, gittest.WithLog("(tag: 0.1.0) feat: add support for basic cloning"))
+
+	client, _ := git.NewClient()
+	exists, err := client.TagExists("0.2.0")
+
+	require.NoError(t, err)
+	assert.False(t, exists)
+}
+
 func TestTagsWithSortBy(t *testing.T) {
 	log := `(tag: 0.11.0) feat: add support for tag sorting and filtering
 (tag: 0.10.0) feat: add support for inspecting a repository
@@ -44,3 +44,7 @@ func reverse(strs ...string) []string {
 
 	return out
 }
+
+func escapeQuotes(str string) string {
+	return strings.ReplaceAll(str, "'", `'\''`)
+}
@@ -0,0 +1,110 @@
+package git
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const gitVersionPrefix = "git version "
+
+// ErrGitVersionUnsupported is raised when the version of git used by
+// the client is older than a required minimum version
+type ErrGitVersionUnsupported struct {
+	// Installed contains the version of git used by the client
+	Installed GitVersion
+
+	// Required contains the minimum version of git that is required
+	Required GitVersion
+}
+
+// Error returns a friendly formatted message of the current error
+func (e ErrGitVersionUnsupported) Error() string {
+	return fmt.Sprintf("git version %s is not supported. version %s or later is required", e.Installed, e.Required)
+}
+
+// GitVersion contains a structured representation of the version of git
+// used by the client. It is not named Version, as that would collide with
+// the existing [Version] sort key
+type GitVersion struct {
+	// Major version number
+	Major int
+
+	// Minor version number
+	Minor int
+
+	// Patch version number
+	Patch int
+
+	// Raw contains the unparsed version as reported by git
+	Raw string
+}
+
+// String returns the version in its semantic version format
+func (v GitVersion) String() string {
+	return fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+}
+
+// VersionInfo parses the version of git used by the client into a structured
+// format. Any vendor specific suffix will be ignored during parsing:
+//
+//	git version 2.39.3 (Apple Git-145) => 2.39.3
+//	git version 2.41.0.windows.1 => 2.41.0
+func (c *Client) VersionInfo() (GitVersion, error) {
+	return parseVersion(c.gitVersion)
+}
+
+// RequireVersion checks that the version of git used by the client is at
+// least the provided minimum version. An [ErrGitVersionUnsupported] error
+// is returned if the installed version is older
+func (c *Client) RequireVersion(min GitVersion) error {
+	installed, err := c.VersionInfo()
+	if err != nil {
+		return err
+	}
+
+	if installed.olderThan(min) {
+		return ErrGitVersionUnsupported{Installed: installed, Required: min}
+	}
+
+	return nil
+}
+
+func (v GitVersion) olderThan(other GitVersion) bool {
+	if v.Major != other.Major {
+		return v.Major < other.Major
+	}
+
+	if v.Minor != other.Minor {
+		return v.Minor < other.Minor
+	}
+
+	return v.Patch < other.Patch
+}
+
+func parseVersion(raw string) (GitVersion, error) {
+	fields := strings.Fields(strings.TrimPrefix(strings.TrimSpace(raw), gitVersionPrefix))
+	if len(fields) == 0 {
+		return GitVersion{}, fmt.Errorf("failed to parse git version: %s", raw)
+	}
+
+	var parts [3]int
+	for i, num := range strings.SplitN(fields[0], ".", 4) {
+		if i == len(parts) {
+			break
+		}
+
+		n, err := strconv.Atoi(num)
+		if err != nil {
+			return GitVersion{}, fmt.Errorf("failed to parse git version: %s", raw)
+		}
+		parts[i] = n
+	}
+
+	return GitVersion{
+		Major: parts[0],
+		Minor: parts[1],
+		Patch: parts[2],
+		Raw:   raw,
+	}, nil
+}
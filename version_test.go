@@ -0,0 +1,88 @@
+package git_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	git "github.com/purpleclay/gitz"
+	"github.com/purpleclay/gitz/gittest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVersionInfo(t *testing.T) {
+	tests := []struct {
+		name     string
+		raw      string
+		expected git.GitVersion
+	}{
+		{
+			name:     "Plain",
+			raw:      "git version 2.39.5",
+			expected: git.GitVersion{Major: 2, Minor: 39, Patch: 5, Raw: "git version 2.39.5"},
+		},
+		{
+			name:     "AppleSuffix",
+			raw:      "git version 2.39.3 (Apple Git-145)",
+			expected: git.GitVersion{Major: 2, Minor: 39, Patch: 3, Raw: "git version 2.39.3 (Apple Git-145)"},
+		},
+		{
+			name:     "WindowsSuffix",
+			raw:      "git version 2.41.0.windows.1",
+			expected: git.GitVersion{Major: 2, Minor: 41, Patch: 0, Raw: "git version 2.41.0.windows.1"},
+		},
+		{
+			name:     "MissingPatch",
+			raw:      "git version 2.40",
+			expected: git.GitVersion{Major: 2, Minor: 40, Patch: 0, Raw: "git version 2.40"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := shimClient(t, tt.raw)
+
+			version, err := client.VersionInfo()
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, version)
+		})
+	}
+}
+
+func TestVersionInfoParseError(t *testing.T) {
+	client := shimClient(t, "git version unknown")
+
+	_, err := client.VersionInfo()
+	assert.EqualError(t, err, "failed to parse git version: git version unknown")
+}
+
+func shimClient(t *testing.T, version string) *git.Client {
+	t.Helper()
+
+	shim := filepath.Join(t.TempDir(), "git-shim")
+	gittest.WriteFile(t, shim, "#!/bin/sh\necho '"+version+"'\n", 0o755)
+
+	client, err := git.NewClient(git.WithGitBinary(shim))
+	require.NoError(t, err)
+
+	return client
+}
+
+func TestRequireVersion(t *testing.T) {
+	client, _ := git.NewClient()
+	installed, err := client.VersionInfo()
+	require.NoError(t, err)
+
+	require.NoError(t, client.RequireVersion(installed))
+	require.NoError(t, client.RequireVersion(git.GitVersion{Major: 1}))
+}
+
+func TestRequireVersionUnsupportedError(t *testing.T) {
+	client := shimClient(t, "git version 2.39.5")
+
+	err := client.RequireVersion(git.GitVersion{Major: 2, Minor: 40})
+	require.ErrorAs(t, err, &git.ErrGitVersionUnsupported{})
+	assert.EqualError(t, err, "git version 2.39.5 is not supported. version 2.40.0 or later is required")
+
+	err = client.RequireVersion(git.GitVersion{Major: 999})
+	require.ErrorAs(t, err, &git.ErrGitVersionUnsupported{})
+}
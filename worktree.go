@@ -0,0 +1,155 @@
+package git
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+)
+
+// Worktree represents a working tree linked to the current repository
+type Worktree struct {
+	// Path to the root of the working tree
+	Path string
+
+	// Head contains the hash of the commit currently checked out
+	// within the working tree
+	Head string
+
+	// Branch contains the name of the branch currently checked out
+	// within the working tree. Will be empty if the working tree
+	// is in a detached HEAD state
+	Branch string
+}
+
+// WorktreeAddOption provides a way for setting specific options during a
+// worktree add operation. Each supported option can customize the way the
+// working tree is created
+type WorktreeAddOption func(*worktreeAddOptions)
+
+type worktreeAddOptions struct {
+	Detach    bool
+	NewBranch string
+}
+
+// WithWorktreeDetach will checkout the reference within the new working
+// tree as a detached HEAD, even if the reference is a branch
+func WithWorktreeDetach() WorktreeAddOption {
+	return func(opts *worktreeAddOptions) {
+		opts.Detach = true
+	}
+}
+
+// WithWorktreeNewBranch will create a new branch, starting at the provided
+// reference, and check it out within the new working tree. An empty string
+// will be ignored
+func WithWorktreeNewBranch(name string) WorktreeAddOption {
+	return func(opts *worktreeAddOptions) {
+		opts.NewBranch = strings.TrimSpace(name)
+	}
+}
+
+// WorktreeAdd creates a new working tree at the provided path and checks
+// out the reference within it. The working tree is linked to the current
+// repository (working directory), sharing all of its history. A branch
+// can only be checked out within a single working tree at any time
+func (c *Client) WorktreeAdd(path, ref string, opts ...WorktreeAddOption) (string, error) {
+	options := &worktreeAddOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	var buf strings.Builder
+	buf.WriteString("git worktree add")
+
+	if options.Detach {
+		buf.WriteString(" --detach")
+	}
+
+	if options.NewBranch != "" {
+		buf.WriteString(" -b ")
+		buf.WriteString(options.NewBranch)
+	}
+
+	buf.WriteString(fmt.Sprintf(" '%s'", strings.TrimSpace(path)))
+	if ref = strings.TrimSpace(ref); ref != "" {
+		buf.WriteString(" ")
+		buf.WriteString(ref)
+	}
+
+	return c.Exec(buf.String())
+}
+
+// WorktreeList retrieves details of all working trees linked to the
+// current repository (working directory), including the main working
+// tree, which is always listed first. Raw output is parsed from the
+// following command:
+//
+//	git worktree list --porcelain
+func (c *Client) WorktreeList() ([]Worktree, error) {
+	out, err := c.Exec("git worktree list --porcelain")
+	if err != nil {
+		return nil, err
+	}
+
+	return parseWorktrees(out), nil
+}
+
+func parseWorktrees(out string) []Worktree {
+	var worktrees []Worktree
+	for _, block := range strings.Split(out, "\n\n") {
+		var wt Worktree
+		for _, line := range strings.Split(block, "\n") {
+			key, value, _ := strings.Cut(line, " ")
+			switch key {
+			case "worktree":
+				wt.Path = filepath.ToSlash(value)
+			case "HEAD":
+				wt.Head = value
+			case "branch":
+				wt.Branch = strings.TrimPrefix(value, "refs/heads/")
+			}
+		}
+
+		if wt.Path != "" {
+			worktrees = append(worktrees, wt)
+		}
+	}
+
+	return worktrees
+}
+
+// WorktreeRemoveOption provides a way for setting specific options during
+// a worktree remove operation
+type WorktreeRemoveOption func(*worktreeRemoveOptions)
+
+type worktreeRemoveOptions struct {
+	Force bool
+}
+
+// WithForceWorktreeRemove will remove a working tree, even if it contains
+// modified or untracked files
+func WithForceWorktreeRemove() WorktreeRemoveOption {
+	return func(opts *worktreeRemoveOptions) {
+		opts.Force = true
+	}
+}
+
+// WorktreeRemove removes a working tree linked to the current repository
+// (working directory). Only clean working trees, without any modified or
+// untracked files, can be removed by default
+func (c *Client) WorktreeRemove(path string, opts ...WorktreeRemoveOption) (string, error) {
+	options := &worktreeRemoveOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	var buf strings.Builder
+	buf.WriteString("git worktree remove")
+
+	if options.Force {
+		buf.WriteString(" --force")
+	}
+
+	buf.WriteString(fmt.Sprintf(" '%s'", strings.TrimSpace(path)))
+	return c.Exec(buf.String())
+}
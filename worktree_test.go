@@ -0,0 +1,85 @@
+package git_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	git "github.com/purpleclay/gitz"
+	"github.com/purpleclay/gitz/gittest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorktreeAddListAndRemove(t *testing.T) {
+	log := "(main, origin/main, feature) feat: build multiple refs in parallel"
+	gittest.InitRepository(t, gittest.WithLog(log))
+	path := filepath.ToSlash(filepath.Join(t.TempDir(), "feature"))
+
+	client, _ := git.NewClient()
+	_, err := client.WorktreeAdd(path, "feature")
+	require.NoError(t, err)
+
+	worktrees, err := client.WorktreeList()
+	require.NoError(t, err)
+
+	require.Len(t, worktrees, 2)
+	assert.Equal(t, gittest.WorkingDirectory(t), worktrees[0].Path)
+	assert.Equal(t, gittest.DefaultBranch, worktrees[0].Branch)
+	assert.Equal(t, path, worktrees[1].Path)
+	assert.Equal(t, "feature", worktrees[1].Branch)
+	assert.Equal(t, gittest.LastCommit(t).Hash, worktrees[1].Head)
+
+	_, err = client.WorktreeRemove(path)
+	require.NoError(t, err)
+
+	worktrees, err = client.WorktreeList()
+	require.NoError(t, err)
+	assert.Len(t, worktrees, 1)
+	assert.NoDirExists(t, path)
+}
+
+func TestWorktreeAddWithWorktreeNewBranch(t *testing.T) {
+	gittest.InitRepository(t)
+	path := filepath.ToSlash(filepath.Join(t.TempDir(), "new-branch"))
+
+	client, _ := git.NewClient()
+	_, err := client.WorktreeAdd(path, gittest.DefaultBranch, git.WithWorktreeNewBranch("new-branch"))
+	require.NoError(t, err)
+
+	worktrees, err := client.WorktreeList()
+	require.NoError(t, err)
+	require.Len(t, worktrees, 2)
+	assert.Equal(t, "new-branch", worktrees[1].Branch)
+}
+
+func TestWorktreeAddWithWorktreeDetach(t *testing.T) {
+	gittest.InitRepository(t)
+	path := filepath.ToSlash(filepath.Join(t.TempDir(), "detached"))
+
+	client, _ := git.NewClient()
+	_, err := client.WorktreeAdd(path, gittest.DefaultBranch, git.WithWorktreeDetach())
+	require.NoError(t, err)
+
+	worktrees, err := client.WorktreeList()
+	require.NoError(t, err)
+	require.Len(t, worktrees, 2)
+	assert.Empty(t, worktrees[1].Branch)
+	assert.Equal(t, gittest.LastCommit(t).Hash, worktrees[1].Head)
+}
+
+func TestWorktreeRemoveWithForceWorktreeRemove(t *testing.T) {
+	gittest.InitRepository(t)
+	path := filepath.ToSlash(filepath.Join(t.TempDir(), "dirty"))
+
+	client, _ := git.NewClient()
+	_, err := client.WorktreeAdd(path, "", git.WithWorktreeNewBranch("dirty"))
+	require.NoError(t, err)
+	gittest.WriteFile(t, filepath.Join(path, "untracked.txt"), "an untracked file", 0o644)
+
+	_, err = client.WorktreeRemove(path)
+	require.Error(t, err)
+
+	_, err = client.WorktreeRemove(path, git.WithForceWorktreeRemove())
+	require.NoError(t, err)
+	assert.NoDirExists(t, path)
+}